@@ -0,0 +1,237 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"dirpx.dev/dxrel/dxcore/compose"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/scopes"
+)
+
+// errUsage reports invalid command-line arguments. The flag set has already
+// printed the problem and the usage when it is returned.
+var errUsage = errors.New("usage error")
+
+// commitCommand implements "dxrel commit": it collects a Conventional
+// Commit message, either from flags or interactively with a
+// compose.Prompter, and passes it to "git commit -F -".
+type commitCommand struct {
+	// In is read for the answers of an interactive session.
+	In io.Reader
+
+	// Out receives the prompts, and the message in dry-run mode.
+	Out io.Writer
+
+	// Err receives usage errors and the diagnostics of git.
+	Err io.Writer
+
+	// Git runs git.
+	Git gitFunc
+
+	// RawMode, if set, switches the terminal of In to raw mode so that the
+	// subject length is shown while it is typed. See compose.Prompter.
+	RawMode func() (restore func() error, err error)
+}
+
+// Run parses args and commits. Arguments after the flags, conventionally
+// introduced by "--", are passed on to "git commit", for example
+// "dxrel commit -type fix -subject x -- --signoff".
+//
+// The message is taken from the flags when -type or -subject is set, and
+// asked for interactively otherwise. Scopes are suggested from the staged
+// files, attributed to scopes by the -scope-rule flags or, without rules,
+// to their top-level directory.
+func (c commitCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("dxrel commit", flag.ContinueOnError)
+	fs.SetOutput(c.Err)
+	fs.Usage = func() {
+		fmt.Fprintf(c.Err, "usage: dxrel commit [flags] [-- git commit arguments]\n\nFlags:\n")
+		fs.PrintDefaults()
+	}
+
+	var (
+		a          compose.Answers
+		typeList   string
+		fixes      string
+		scopeRules ruleList
+		length     lengthFlag
+		dryRun     bool
+	)
+	fs.StringVar(&a.Type, "type", "", "commit `type`, for example feat or fix")
+	fs.StringVar(&a.Scope, "scope", "", "commit `scope`; several may be separated by commas")
+	fs.StringVar(&a.Subject, "subject", "", "short description of the change")
+	fs.StringVar(&a.Body, "body", "", "free-form message body")
+	fs.BoolVar(&a.Breaking, "breaking", false, "mark the change as breaking")
+	fs.StringVar(&a.BreakingChange, "breaking-change", "", "`description` of a breaking change (implies -breaking)")
+	fs.StringVar(&fixes, "fixes", "", "comma-separated `issues` fixed by the change, for example \"#12,owner/repo#3\"")
+	fs.StringVar(&typeList, "types", "", "comma-separated `types` offered by the interactive prompt (default: all)")
+	fs.Var(&scopeRules, "scope-rule", "map staged paths matching a pattern to a suggested scope, as `pattern=scope`; repeatable")
+	fs.Var(&length, "length", "how the subject length is measured: runes, graphemes or width")
+	fs.BoolVar(&dryRun, "n", false, "print the message instead of committing")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errUsage
+	}
+
+	a.SubjectOptions = conventional.SubjectOptions{Length: conventional.LengthMode(length)}
+
+	var (
+		msg conventional.Message
+		err error
+	)
+	if a.Type != "" || a.Subject != "" {
+		a.Issues = splitList(fixes)
+		msg, err = a.Message()
+	} else {
+		msg, err = c.prompt(typeList, scopeRules, a.SubjectOptions)
+	}
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		fmt.Fprintln(c.Out, msg.String())
+		return nil
+	}
+	gitArgs := append([]string{"commit", "-F", "-"}, fs.Args()...)
+	if err := c.Git(strings.NewReader(msg.String()), c.Out, c.Err, gitArgs...); err != nil {
+		return fmt.Errorf("git commit: %w", err)
+	}
+	return nil
+}
+
+// prompt runs an interactive session offering the types of typeList and
+// the scopes of the staged files, measuring the subject with opts.
+func (c commitCommand) prompt(typeList string, rules []scopes.Rule, opts conventional.SubjectOptions) (conventional.Message, error) {
+	p := compose.Prompter{In: c.In, Out: c.Out, SubjectOptions: opts, RawMode: c.RawMode}
+	for _, s := range splitList(typeList) {
+		t, err := conventional.ParseType(s)
+		if err != nil {
+			return conventional.Message{}, fmt.Errorf("invalid -types: %w", err)
+		}
+		p.Types = append(p.Types, t)
+	}
+
+	changes, err := c.stagedChanges()
+	if err != nil {
+		return conventional.Message{}, err
+	}
+	if len(rules) == 0 {
+		rules = directoryRules(changes)
+	}
+	for _, n := range scopes.Infer(changes, rules) {
+		p.Scopes = append(p.Scopes, n.Scope.String())
+	}
+
+	return p.Run()
+}
+
+// stagedChanges lists the files staged for the next commit.
+func (c commitCommand) stagedChanges() ([]git.FileChange, error) {
+	var out bytes.Buffer
+	if err := c.Git(nil, &out, c.Err, "diff", "--cached", "--name-only", "-z"); err != nil {
+		return nil, fmt.Errorf("list staged files: %w", err)
+	}
+	var changes []git.FileChange
+	for _, p := range strings.Split(out.String(), "\x00") {
+		if p != "" {
+			changes = append(changes, git.FileChange{Path: p})
+		}
+	}
+	return changes, nil
+}
+
+// directoryRules returns a rule per top-level directory of changes whose
+// name is a valid scope, so that each directory suggests itself.
+func directoryRules(changes []git.FileChange) []scopes.Rule {
+	var rules []scopes.Rule
+	seen := make(map[string]bool)
+	for _, fc := range changes {
+		dir, _, ok := strings.Cut(path.Clean(fc.Path), "/")
+		if !ok || seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		if s, err := conventional.ParseScope(dir); err == nil {
+			rules = append(rules, scopes.Rule{Pattern: dir + "/", Scope: s})
+		}
+	}
+	return rules
+}
+
+// splitList splits a comma-separated list, dropping blanks.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ruleList is a repeatable flag of "pattern=scope" scope rules.
+type ruleList []scopes.Rule
+
+func (l *ruleList) String() string {
+	var parts []string
+	for _, r := range *l {
+		parts = append(parts, r.Pattern+"="+r.Scope.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+func (l *ruleList) Set(s string) error {
+	pattern, scope, ok := strings.Cut(s, "=")
+	if !ok {
+		return fmt.Errorf("%q is not of the form pattern=scope", s)
+	}
+	r := scopes.Rule{Pattern: pattern, Scope: conventional.Scope(scope)}
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	*l = append(*l, r)
+	return nil
+}
+
+// lengthFlag is a flag naming a conventional.LengthMode.
+type lengthFlag conventional.LengthMode
+
+func (f *lengthFlag) String() string {
+	return conventional.LengthMode(*f).String()
+}
+
+func (f *lengthFlag) Set(s string) error {
+	for m := conventional.LengthRunes; m.Valid(); m++ {
+		if m.String() == s {
+			*f = lengthFlag(m)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown length mode %q", s)
+}
@@ -0,0 +1,217 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fakeGit records the git invocations of a command and answers
+// "git diff --cached" with staged.
+type fakeGit struct {
+	staged  []string
+	calls   [][]string
+	message string
+}
+
+func (g *fakeGit) run(stdin io.Reader, stdout, _ io.Writer, args ...string) error {
+	g.calls = append(g.calls, args)
+	switch args[0] {
+	case "diff":
+		_, err := io.WriteString(stdout, strings.Join(g.staged, "\x00")+"\x00")
+		return err
+	case "commit":
+		b, err := io.ReadAll(stdin)
+		g.message = string(b)
+		return err
+	}
+	return nil
+}
+
+func TestCommit_Flags(t *testing.T) {
+	g := &fakeGit{}
+	cmd := commitCommand{In: strings.NewReader(""), Out: io.Discard, Err: io.Discard, Git: g.run}
+	err := cmd.Run([]string{
+		"-type", "feat", "-scope", "api,cli", "-subject", "add search",
+		"-breaking-change", "the /find endpoint was removed", "-fixes", "#12, owner/repo#3",
+		"--", "--signoff",
+	})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	want := "feat(api,cli)!: add search\n\n" +
+		"BREAKING CHANGE: the /find endpoint was removed\n" +
+		"Fixes: #12, owner/repo#3"
+	if g.message != want {
+		t.Errorf("message =\n%s\nwant\n%s", g.message, want)
+	}
+	if want := [][]string{{"commit", "-F", "-", "--signoff"}}; !reflect.DeepEqual(g.calls, want) {
+		t.Errorf("git calls = %q, want %q", g.calls, want)
+	}
+}
+
+func TestCommit_Interactive(t *testing.T) {
+	g := &fakeGit{staged: []string{"api/search.go", "api/find.go", "cli/main.go", "README.md"}}
+	input := strings.Join([]string{
+		"2", // fix
+		"",  // default scope suggestion
+		"repair search paging",
+		"",   // no body
+		"",   // not breaking
+		"#7", // fixed issues
+	}, "\n") + "\n"
+	var out strings.Builder
+	cmd := commitCommand{In: strings.NewReader(input), Out: &out, Err: io.Discard, Git: g.run}
+	if err := cmd.Run([]string{"-types", "feat,fix"}); err != nil {
+		t.Fatalf("Run() error = %v\n%s", err, out.String())
+	}
+
+	if want := "fix(api): repair search paging\n\nFixes: #7"; g.message != want {
+		t.Errorf("message =\n%s\nwant\n%s", g.message, want)
+	}
+	for _, s := range []string{"1) feat\n  2) fix\n", "suggested: api, cli;", "20/72 characters"} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("transcript does not contain %q:\n%s", s, out.String())
+		}
+	}
+}
+
+func TestCommit_ScopeRules(t *testing.T) {
+	g := &fakeGit{staged: []string{"internal/api/search.go"}}
+	var out strings.Builder
+	cmd := commitCommand{In: strings.NewReader("feat\n"), Out: &out, Err: io.Discard, Git: g.run}
+	_ = cmd.Run([]string{"-scope-rule", "internal/api=api"})
+	if !strings.Contains(out.String(), "suggested: api;") {
+		t.Errorf("transcript does not suggest api:\n%s", out.String())
+	}
+}
+
+func TestCommit_Length(t *testing.T) {
+	subject := strings.Repeat("\u65b0", 40) // 40 runes, 80 columns
+
+	g := &fakeGit{}
+	cmd := commitCommand{Out: io.Discard, Err: io.Discard, Git: g.run}
+	if err := cmd.Run([]string{"-n", "-type", "fix", "-subject", subject}); err != nil {
+		t.Errorf("Run() error = %v", err)
+	}
+	if err := cmd.Run([]string{"-n", "-length", "width", "-type", "fix", "-subject", subject}); err == nil {
+		t.Error("Run(-length width) accepted an 80-column subject")
+	}
+	if err := cmd.Run([]string{"-length", "bytes"}); !errors.Is(err, errUsage) {
+		t.Errorf("Run(-length bytes) error = %v, want errUsage", err)
+	}
+
+	var out strings.Builder
+	var raw, restored int
+	cmd = commitCommand{
+		In:  strings.NewReader("fix\n\n" + subject + "\r\n\n\n"),
+		Out: &out, Err: io.Discard, Git: g.run,
+		RawMode: func() (func() error, error) {
+			raw++
+			return func() error { restored++; return nil }, nil
+		},
+	}
+	_ = cmd.Run([]string{"-length", "width"})
+	if raw != 1 || restored != 1 {
+		t.Errorf("raw mode switched %d times and restored %d times, want once", raw, restored)
+	}
+	if !strings.Contains(out.String(), "Subject [80/72]: ") {
+		t.Errorf("transcript does not count columns:\n%q", out.String())
+	}
+}
+
+func TestCommit_DryRun(t *testing.T) {
+	g := &fakeGit{}
+	var out strings.Builder
+	cmd := commitCommand{Out: &out, Err: io.Discard, Git: g.run}
+	if err := cmd.Run([]string{"-n", "-type", "docs", "-subject", "fix typo"}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if got := out.String(); got != "docs: fix typo\n" {
+		t.Errorf("output = %q", got)
+	}
+	if len(g.calls) != 0 {
+		t.Errorf("git calls = %q, want none", g.calls)
+	}
+}
+
+func TestRun_ExitStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"no_command", nil, 2},
+		{"unknown_command", []string{"publish"}, 2},
+		{"unknown_flag", []string{"commit", "-nope"}, 2},
+		{"bad_scope_rule", []string{"commit", "-scope-rule", "cli"}, 2},
+		{"help", []string{"commit", "-h"}, 0},
+		{"invalid_message", []string{"commit", "-n", "-type", "feature", "-subject", "x"}, 1},
+		{"dry_run", []string{"commit", "-n", "-type", "feat", "-subject", "x"}, 0},
+		{"fmt_usage", []string{"fmt", "-w"}, 2},
+		{"fmt_invalid", []string{"fmt"}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(tt.args, strings.NewReader(""), io.Discard, io.Discard); got != tt.want {
+				t.Errorf("run(%q) = %d, want %d", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommit_Git(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+	dir := t.TempDir()
+	t.Chdir(dir)
+	for _, env := range []string{"GIT_AUTHOR_NAME", "GIT_COMMITTER_NAME"} {
+		t.Setenv(env, "Jane Doe")
+	}
+	for _, env := range []string{"GIT_AUTHOR_EMAIL", "GIT_COMMITTER_EMAIL"} {
+		t.Setenv(env, "jane@example.com")
+	}
+	t.Setenv("GIT_CONFIG_GLOBAL", os.DevNull)
+	gitC := func(args ...string) string {
+		out, err := exec.Command("git", args...).CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+		}
+		return string(out)
+	}
+	gitC("init", "-q")
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	gitC("add", "a.txt")
+
+	if code := run([]string{"commit", "-type", "chore", "-subject", "add a", "--", "-q"}, nil, io.Discard, io.Discard); code != 0 {
+		t.Fatalf("run() = %d", code)
+	}
+	if got := gitC("log", "-1", "--format=%B"); got != "chore: add a\n\n" {
+		t.Errorf("commit message = %q", got)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+// fmtCommand implements "dxrel fmt": it rewrites a Conventional Commit
+// message in canonical form with conventional.FormatMessage.
+type fmtCommand struct {
+	// In is read for the message when no file is named.
+	In io.Reader
+
+	// Out receives the formatted message unless it is written in place.
+	Out io.Writer
+
+	// Err receives usage errors.
+	Err io.Writer
+}
+
+// Run parses args and formats the message of the file named by the only
+// argument, or of In without one. With -w the file is rewritten in place,
+// which makes the command usable as a commit-msg hook.
+func (c fmtCommand) Run(args []string) error {
+	fs := flag.NewFlagSet("dxrel fmt", flag.ContinueOnError)
+	fs.SetOutput(c.Err)
+	fs.Usage = func() {
+		fmt.Fprintf(c.Err, "usage: dxrel fmt [flags] [file]\n\nFlags:\n")
+		fs.PrintDefaults()
+	}
+
+	var (
+		opts   conventional.FormatOptions
+		length lengthFlag
+		write  bool
+	)
+	fs.IntVar(&opts.Width, "width", conventional.DefaultFormatWidth, "`column` at which body paragraphs are wrapped; negative disables wrapping")
+	fs.Var(&length, "length", "how the subject length is measured: runes, graphemes or width")
+	fs.BoolVar(&write, "w", false, "write the result to the file instead of standard output")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errUsage
+	}
+	if fs.NArg() > 1 || write && fs.NArg() == 0 {
+		fs.Usage()
+		return errUsage
+	}
+	opts.Subject = conventional.SubjectOptions{Length: conventional.LengthMode(length)}
+
+	var (
+		raw []byte
+		err error
+	)
+	if fs.NArg() == 1 {
+		raw, err = os.ReadFile(fs.Arg(0))
+	} else {
+		raw, err = io.ReadAll(c.In)
+	}
+	if err != nil {
+		return err
+	}
+
+	out, err := conventional.FormatMessage(string(raw), opts)
+	if err != nil {
+		return err
+	}
+	out += "\n"
+
+	if !write {
+		_, err = io.WriteString(c.Out, out)
+		return err
+	}
+	fi, err := os.Stat(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fs.Arg(0), []byte(out), fi.Mode().Perm())
+}
@@ -0,0 +1,96 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFmt_Stdin(t *testing.T) {
+	var out strings.Builder
+	cmd := fmtCommand{In: strings.NewReader("fix: repair x\n\nshort\nlines\njoined\n"), Out: &out, Err: io.Discard}
+	if err := cmd.Run(nil); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if want := "fix: repair x\n\nshort lines joined\n"; out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestFmt_File(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "COMMIT_EDITMSG")
+	input := "fix: repair x\n\nThis is a rather long line of prose that should be wrapped.\n"
+	if err := os.WriteFile(name, []byte(input), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	cmd := fmtCommand{Out: &out, Err: io.Discard}
+	if err := cmd.Run([]string{"-width", "30", name}); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	want := "fix: repair x\n\nThis is a rather long line of\nprose that should be wrapped.\n"
+	if out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+
+	out.Reset()
+	if err := cmd.Run([]string{"-w", "-width", "30", name}); err != nil {
+		t.Fatalf("Run(-w) error = %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("Run(-w) wrote %q to Out", out.String())
+	}
+	got, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Errorf("file = %q, want %q", got, want)
+	}
+	if fi, err := os.Stat(name); err != nil || fi.Mode().Perm() != 0o600 {
+		t.Errorf("file mode = %v, %v; want 0600", fi.Mode(), err)
+	}
+}
+
+func TestFmt_Errors(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "msg")
+	if err := os.WriteFile(name, []byte("not a conventional commit\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := fmtCommand{In: strings.NewReader(""), Out: io.Discard, Err: io.Discard}
+	for _, args := range [][]string{{"-w"}, {"a", "b"}, {"-length", "bytes"}} {
+		if err := cmd.Run(args); !errors.Is(err, errUsage) {
+			t.Errorf("Run(%q) error = %v, want errUsage", args, err)
+		}
+	}
+	if err := cmd.Run([]string{"-w", name}); err == nil {
+		t.Error("Run() formatted an invalid message")
+	}
+	if got, _ := os.ReadFile(name); string(got) != "not a conventional commit\n" {
+		t.Errorf("invalid message rewritten to %q", got)
+	}
+	if err := cmd.Run([]string{filepath.Join(t.TempDir(), "missing")}); err == nil {
+		t.Error("Run() succeeded on a missing file")
+	}
+}
@@ -0,0 +1,111 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Command dxrel is the command-line interface of dxrel.
+//
+// Usage:
+//
+//	dxrel commit [flags] [-- git commit arguments]
+//	dxrel fmt [flags] [file]
+//
+// Run "dxrel <command> -h" for the flags of a command.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+
+	"golang.org/x/term"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command line args and returns the exit status: 0 on
+// success, 1 on failure and 2 on a usage error.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	var err error
+	switch args[0] {
+	case "commit":
+		cmd := commitCommand{In: stdin, Out: stdout, Err: stderr, Git: execGit, RawMode: rawMode(stdin)}
+		err = cmd.Run(args[1:])
+	case "fmt":
+		cmd := fmtCommand{In: stdin, Out: stdout, Err: stderr}
+		err = cmd.Run(args[1:])
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	default:
+		fmt.Fprintf(stderr, "dxrel: unknown command %q\n%s", args[0], usage)
+		return 2
+	}
+
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUsage):
+		return 2
+	default:
+		fmt.Fprintf(stderr, "dxrel: %v\n", err)
+		return 1
+	}
+}
+
+const usage = `usage: dxrel <command> [arguments]
+
+Commands:
+  commit    write a Conventional Commit message and run "git commit"
+  fmt       rewrite a commit message in canonical form
+`
+
+// rawMode returns a function switching stdin to raw mode when it is a
+// terminal, and nil otherwise.
+func rawMode(stdin io.Reader) func() (func() error, error) {
+	f, ok := stdin.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return nil
+	}
+	return func() (func() error, error) {
+		state, err := term.MakeRaw(int(f.Fd()))
+		if err != nil {
+			return nil, err
+		}
+		return func() error { return term.Restore(int(f.Fd()), state) }, nil
+	}
+}
+
+// gitFunc runs git with args, reading stdin and writing its output to
+// stdout and stderr.
+type gitFunc func(stdin io.Reader, stdout, stderr io.Writer, args ...string) error
+
+// execGit runs the git executable found in PATH.
+func execGit(stdin io.Reader, stdout, stderr io.Writer, args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	return cmd.Run()
+}
@@ -0,0 +1,355 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package compose implements commit message authoring: it collects the parts
+// of a Conventional Commit either interactively, by prompting on a line-based
+// terminal, or from pre-filled Answers (for example, command-line flags), and
+// produces a validated conventional.Message.
+//
+// The package performs no git operations. A command such as "dxrel commit"
+// runs a Prompter (or builds Answers from its flags), then passes
+// Message.String() to "git commit -F -". Because Prompter reads from an
+// io.Reader and writes to an io.Writer, interactive sessions can be tested
+// with scripted input.
+package compose
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+// Answers holds the parts of a commit message in their textual form.
+type Answers struct {
+	// Type is the commit type, for example "feat". It is required.
+	Type string
+
+	// Scope is the optional scope, for example "api". Several scopes MAY be
+	// given separated by commas ("api,cli").
+	Scope string
+
+	// Subject is the short description. It is required.
+	Subject string
+
+	// Body is the optional free-form body.
+	Body string
+
+	// Breaking marks the change as breaking with the "!" header marker.
+	Breaking bool
+
+	// BreakingChange describes a breaking change. A non-empty value adds a
+	// BREAKING CHANGE footer and implies Breaking.
+	BreakingChange string
+
+	// Issues lists issue references ("#12", "owner/repo#3", URLs) that the
+	// change fixes. They are written as a single Fixes trailer.
+	Issues []string
+
+	// SubjectOptions are the options the subject is parsed and validated
+	// with (see conventional.SubjectOptions). The resulting message keeps
+	// them.
+	SubjectOptions conventional.SubjectOptions
+}
+
+// Message builds and validates the message described by a.
+func (a Answers) Message() (conventional.Message, error) {
+	t, err := conventional.ParseType(strings.TrimSpace(a.Type))
+	if err != nil {
+		return conventional.Message{}, errors.Nest(err, conventional.Message{}.TypeName(), "Type")
+	}
+
+	b := conventional.NewMessageBuilder().
+		WithType(t).
+		WithScopes(splitScopes(a.Scope)...).
+		WithSubjectOptions(a.SubjectOptions).
+		WithSubject(strings.TrimSpace(a.Subject)).
+		WithBody(strings.TrimSpace(a.Body))
+	if a.Breaking {
+		b.Breaking()
+	}
+	if desc := strings.TrimSpace(a.BreakingChange); desc != "" {
+		b.BreakingChange(desc)
+	}
+
+	var refs []conventional.IssueRef
+	for _, s := range a.Issues {
+		ref, err := conventional.ParseIssueRef(s)
+		if err != nil {
+			return conventional.Message{}, err
+		}
+		refs = append(refs, ref)
+	}
+	if len(refs) > 0 {
+		b.Fixes(refs...)
+	}
+
+	return b.Build()
+}
+
+// Prompter collects Answers interactively on a line-based terminal.
+//
+// Each question is asked until the answer is valid; invalid answers are
+// explained and the question is repeated. Run fails only when the input ends
+// or cannot be read.
+type Prompter struct {
+	// In is read line by line for answers.
+	In io.Reader
+
+	// Out receives the questions and feedback.
+	Out io.Writer
+
+	// Types lists the selectable commit types, in display order. Nil means
+	// every conventional.Type.
+	Types []conventional.Type
+
+	// Scopes lists suggested scopes, for example the scopes that
+	// scopes.Infer derives from the staged files. The first suggestion is the
+	// default answer.
+	Scopes []string
+
+	// SubjectOptions are the options the subject is counted and validated
+	// with. The zero value counts runes against conventional.SubjectMaxLen.
+	SubjectOptions conventional.SubjectOptions
+
+	// RawMode, when set, switches the terminal In reads from to raw mode
+	// and returns a function that restores it. The subject is then edited
+	// in place, one keystroke at a time, with a length counter that is
+	// updated as the user types (see askSubjectLive). When RawMode is nil,
+	// the subject is read as a line and its length reported afterwards.
+	RawMode func() (restore func() error, err error)
+
+	in *bufio.Reader
+}
+
+// Run asks for type, scope, subject, body, breaking change and issue
+// references, and returns the resulting message.
+func (p *Prompter) Run() (conventional.Message, error) {
+	p.in = bufio.NewReader(p.In)
+
+	a := Answers{SubjectOptions: p.SubjectOptions}
+	var err error
+	if a.Type, err = p.askType(); err != nil {
+		return conventional.Message{}, err
+	}
+	if a.Scope, err = p.askScope(); err != nil {
+		return conventional.Message{}, err
+	}
+	if a.Subject, err = p.askSubject(); err != nil {
+		return conventional.Message{}, err
+	}
+	if a.Body, err = p.askBody(); err != nil {
+		return conventional.Message{}, err
+	}
+	if a.BreakingChange, err = p.askBreakingChange(); err != nil {
+		return conventional.Message{}, err
+	}
+	if a.Issues, err = p.askIssues(); err != nil {
+		return conventional.Message{}, err
+	}
+
+	return a.Message()
+}
+
+// askType asks for a type by number or name.
+func (p *Prompter) askType() (string, error) {
+	types := p.Types
+	if types == nil {
+		for t := conventional.Feat; t.Validate() == nil; t++ {
+			types = append(types, t)
+		}
+	}
+
+	p.printf("Type of change:\n")
+	for i, t := range types {
+		p.printf("  %d) %s\n", i+1, t)
+	}
+	for {
+		line, err := p.ask("Type: ")
+		if err != nil {
+			return "", err
+		}
+		if n, err := strconv.Atoi(line); err == nil && n >= 1 && n <= len(types) {
+			return types[n-1].String(), nil
+		}
+		for _, t := range types {
+			if line == t.String() {
+				return line, nil
+			}
+		}
+		p.printf("Unknown type %q; enter a number or one of the listed names.\n", line)
+	}
+}
+
+// askScope asks for an optional scope, offering the suggestions.
+func (p *Prompter) askScope() (string, error) {
+	prompt := "Scope, comma-separated for several (empty for none): "
+	if len(p.Scopes) > 0 {
+		prompt = fmt.Sprintf("Scope (suggested: %s; \"-\" for none) [%s]: ", strings.Join(p.Scopes, ", "), p.Scopes[0])
+	}
+	for {
+		line, err := p.ask(prompt)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case line == "" && len(p.Scopes) > 0:
+			return p.Scopes[0], nil
+		case line == "" || line == "-":
+			return "", nil
+		}
+		if err := conventional.NewMessageBuilder().WithScopes(splitScopes(line)...).Err(); err != nil {
+			p.printf("Invalid scope: %v\n", err)
+			continue
+		}
+		return line, nil
+	}
+}
+
+// askSubject asks for the subject and reports its length, measured with
+// SubjectOptions, against the maximum length. With RawMode, the subject is
+// edited with askSubjectLive instead.
+func (p *Prompter) askSubject() (string, error) {
+	if p.RawMode != nil {
+		return p.askSubjectLive()
+	}
+	maxLen, unit := p.subjectLimit()
+	for {
+		line, err := p.ask(fmt.Sprintf("Subject (max %d %s): ", maxLen, unit))
+		if err != nil {
+			return "", err
+		}
+		n := conventional.TextLength(line, p.SubjectOptions.Length)
+		if _, err := conventional.ParseSubjectWith(line, p.SubjectOptions); err != nil {
+			p.printf("Invalid subject (%d/%d): %v\n", n, maxLen, err)
+			continue
+		}
+		p.printf("  %d/%d %s\n", n, maxLen, unit)
+		return line, nil
+	}
+}
+
+// subjectLimit returns the maximum subject length of SubjectOptions and the
+// unit it is measured in.
+func (p *Prompter) subjectLimit() (int, string) {
+	maxLen := p.SubjectOptions.MaxLen
+	if maxLen <= 0 {
+		maxLen = conventional.SubjectMaxLen
+	}
+	if p.SubjectOptions.Length == conventional.LengthDisplayWidth {
+		return maxLen, "columns"
+	}
+	return maxLen, "characters"
+}
+
+// askBody reads body lines until an empty line.
+func (p *Prompter) askBody() (string, error) {
+	p.printf("Body (end with an empty line):\n")
+	var lines []string
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			return "", err
+		}
+		if strings.TrimSpace(line) == "" {
+			return strings.Join(lines, "\n"), nil
+		}
+		lines = append(lines, line)
+	}
+}
+
+// askBreakingChange asks for an optional breaking change description.
+func (p *Prompter) askBreakingChange() (string, error) {
+	for {
+		line, err := p.ask("Breaking change description (empty if none): ")
+		if err != nil {
+			return "", err
+		}
+		if line == "" {
+			return "", nil
+		}
+		tr := conventional.Trailer{Key: conventional.BreakingChangeTrailerKey, Value: line}
+		if err := tr.Validate(); err != nil {
+			p.printf("Invalid breaking change description: %v\n", err)
+			continue
+		}
+		return line, nil
+	}
+}
+
+// askIssues asks for issue references separated by commas or spaces.
+func (p *Prompter) askIssues() ([]string, error) {
+	for {
+		line, err := p.ask("Fixed issues (e.g. #12, owner/repo#3; empty if none): ")
+		if err != nil {
+			return nil, err
+		}
+		fields := strings.FieldsFunc(line, func(r rune) bool { return r == ',' || r == ' ' || r == ';' })
+		var bad []string
+		for _, f := range fields {
+			if _, err := conventional.ParseIssueRef(f); err != nil {
+				bad = append(bad, f)
+			}
+		}
+		if len(bad) > 0 {
+			p.printf("Not issue references: %s\n", strings.Join(bad, ", "))
+			continue
+		}
+		return fields, nil
+	}
+}
+
+// splitScopes splits a comma-separated scope list, dropping blanks.
+func splitScopes(s string) []string {
+	var scopes []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			scopes = append(scopes, part)
+		}
+	}
+	return scopes
+}
+
+// ask prints prompt and returns the next input line, trimmed.
+func (p *Prompter) ask(prompt string) (string, error) {
+	p.printf("%s", prompt)
+	line, err := p.readLine()
+	return strings.TrimSpace(line), err
+}
+
+// readLine returns the next input line without its line ending. It returns
+// io.ErrUnexpectedEOF when the input ends before the session is complete.
+func (p *Prompter) readLine() (string, error) {
+	line, err := p.in.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	if err == io.EOF {
+		return "", io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"), nil
+}
+
+func (p *Prompter) printf(format string, args ...any) {
+	fmt.Fprintf(p.Out, format, args...)
+}
@@ -0,0 +1,131 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package compose_test
+
+import (
+	stderrors "errors"
+	"io"
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/compose"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+func TestPrompter_Run(t *testing.T) {
+	input := strings.Join([]string{
+		"nope", // unknown type, asked again
+		"1",    // feat
+		"",     // default scope suggestion
+		strings.Repeat("x", conventional.SubjectMaxLen+1), // too long, asked again
+		"add search endpoint",
+		"Adds a search endpoint.",
+		"",
+		"the /find endpoint was removed",
+		"#12, bogus",
+		"#12 owner/repo#3",
+	}, "\n") + "\n"
+
+	var out strings.Builder
+	p := compose.Prompter{
+		In:     strings.NewReader(input),
+		Out:    &out,
+		Types:  []conventional.Type{conventional.Feat, conventional.Fix},
+		Scopes: []string{"api", "cli"},
+	}
+
+	msg, err := p.Run()
+	if err != nil {
+		t.Fatalf("Run() error = %v\n%s", err, out.String())
+	}
+
+	want := "feat(api)!: add search endpoint\n\n" +
+		"Adds a search endpoint.\n\n" +
+		"BREAKING CHANGE: the /find endpoint was removed\n" +
+		"Fixes: #12, owner/repo#3"
+	if got := msg.String(); got != want {
+		t.Errorf("Run() =\n%s\nwant\n%s", got, want)
+	}
+
+	transcript := out.String()
+	for _, s := range []string{
+		`Unknown type "nope"`,
+		"Invalid subject (73/72)",
+		"19/72 characters",
+		"Not issue references: bogus",
+	} {
+		if !strings.Contains(transcript, s) {
+			t.Errorf("transcript does not contain %q:\n%s", s, transcript)
+		}
+	}
+}
+
+func TestPrompter_Run_NoScope(t *testing.T) {
+	p := compose.Prompter{
+		In:  strings.NewReader("fix\n\nrepair x\n\n\n\n"),
+		Out: io.Discard,
+	}
+	msg, err := p.Run()
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if got := msg.String(); got != "fix: repair x" {
+		t.Errorf("Run() = %q, want %q", got, "fix: repair x")
+	}
+}
+
+func TestPrompter_Run_EOF(t *testing.T) {
+	p := compose.Prompter{In: strings.NewReader("feat\n"), Out: io.Discard}
+	if _, err := p.Run(); !stderrors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Run() error = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestAnswers_Message(t *testing.T) {
+	tests := []struct {
+		name    string
+		answers compose.Answers
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "minimal",
+			answers: compose.Answers{Type: "docs", Subject: "explain x"},
+			want:    "docs: explain x",
+		},
+		{
+			name:    "breaking_flag",
+			answers: compose.Answers{Type: "feat", Scope: "cli", Subject: "drop x", Breaking: true, Issues: []string{"#4"}},
+			want:    "feat(cli)!: drop x\n\nFixes: #4",
+		},
+		{name: "unknown_type", answers: compose.Answers{Type: "feature", Subject: "x"}, wantErr: true},
+		{name: "missing_subject", answers: compose.Answers{Type: "fix"}, wantErr: true},
+		{name: "bad_issue", answers: compose.Answers{Type: "fix", Subject: "x", Issues: []string{"12"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := tt.answers.Message()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Message() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && msg.String() != tt.want {
+				t.Errorf("Message() = %q, want %q", msg.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+// ErrInterrupted is returned by Prompter.Run when the user presses Ctrl-C
+// while the subject is edited in raw mode.
+var ErrInterrupted = errors.New("interrupted")
+
+// Keys recognized by askSubjectLive. In raw mode the terminal delivers them
+// as bytes instead of handling them itself.
+const (
+	keyInterrupt = 0x03 // Ctrl-C
+	keyEOF       = 0x04 // Ctrl-D
+	keyBackspace = 0x08 // Ctrl-H
+	keyEnter     = '\r'
+	keyNewline   = '\n'
+	keyKill      = 0x15 // Ctrl-U
+	keyEscape    = 0x1b
+	keyDelete    = 0x7f
+)
+
+// askSubjectLive edits the subject in place with the terminal in raw mode.
+// After every keystroke the line is redrawn as
+//
+//	Subject [n/max]: text
+//
+// with n measured according to SubjectOptions, so that the user sees the
+// length while typing. Backspace deletes the last character, Ctrl-U the
+// whole line, and escape sequences such as arrow keys are ignored. Enter
+// validates the subject; an invalid subject is explained and can be edited
+// further.
+func (p *Prompter) askSubjectLive() (string, error) {
+	restore, err := p.RawMode()
+	if err != nil {
+		return "", fmt.Errorf("switch terminal to raw mode: %w", err)
+	}
+	defer restore()
+
+	maxLen, _ := p.subjectLimit()
+	var text []rune
+	redraw := func() {
+		n := conventional.TextLength(string(text), p.SubjectOptions.Length)
+		p.printf("\r\x1b[KSubject [%d/%d]: %s", n, maxLen, string(text))
+	}
+
+	redraw()
+	for {
+		r, _, err := p.in.ReadRune()
+		if err == io.EOF {
+			return "", io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch r {
+		case keyInterrupt:
+			p.printf("\r\n")
+			return "", ErrInterrupted
+		case keyEOF:
+			if len(text) == 0 {
+				p.printf("\r\n")
+				return "", io.ErrUnexpectedEOF
+			}
+		case keyEnter, keyNewline:
+			p.printf("\r\n")
+			if _, err := conventional.ParseSubjectWith(string(text), p.SubjectOptions); err != nil {
+				p.printf("Invalid subject: %v\r\n", err)
+				break
+			}
+			return string(text), nil
+		case keyBackspace, keyDelete:
+			if len(text) > 0 {
+				text = text[:len(text)-1]
+			}
+		case keyKill:
+			text = text[:0]
+		case keyEscape:
+			if err := p.skipEscape(); err != nil {
+				return "", err
+			}
+		default:
+			if r >= ' ' {
+				text = append(text, r)
+			}
+		}
+		redraw()
+	}
+}
+
+// skipEscape consumes the rest of an escape sequence: a CSI sequence
+// ("ESC [ ... final") or a two-byte sequence ("ESC x").
+func (p *Prompter) skipEscape() error {
+	b, err := p.in.ReadByte()
+	if err != nil || b != '[' {
+		return ignoreEOF(err)
+	}
+	for {
+		b, err := p.in.ReadByte()
+		if err != nil {
+			return ignoreEOF(err)
+		}
+		if b >= 0x40 && b <= 0x7e {
+			return nil
+		}
+	}
+}
+
+// ignoreEOF returns nil for io.EOF, which the next read reports again.
+func ignoreEOF(err error) error {
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,138 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package compose_test
+
+import (
+	stderrors "errors"
+	"io"
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/compose"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+// rawTerminal records the raw mode switches of a Prompter.
+type rawTerminal struct {
+	raw, restored int
+}
+
+func (r *rawTerminal) makeRaw() (func() error, error) {
+	r.raw++
+	return func() error {
+		r.restored++
+		return nil
+	}, nil
+}
+
+func TestPrompter_Run_LiveSubject(t *testing.T) {
+	long := strings.Repeat("x", conventional.SubjectMaxLen+1)
+	input := "fix\n" + // type, read as a line
+		"\n" + // no scope
+		"add serch\x7f\x7f\x7farch" + // typo corrected with backspace
+		"\x1b[D" + // left arrow, ignored
+		"\x15" + // Ctrl-U clears the line
+		long + "\r" + // too long, rejected
+		"\x15repair search\r" +
+		"\n\n\n" // no body, breaking change or issues
+
+	var out strings.Builder
+	term := &rawTerminal{}
+	p := compose.Prompter{In: strings.NewReader(input), Out: &out, RawMode: term.makeRaw}
+	msg, err := p.Run()
+	if err != nil {
+		t.Fatalf("Run() error = %v\n%s", err, out.String())
+	}
+	if got := msg.String(); got != "fix: repair search" {
+		t.Errorf("Run() = %q", got)
+	}
+	if term.raw != 1 || term.restored != 1 {
+		t.Errorf("raw mode switched %d times and restored %d times, want once", term.raw, term.restored)
+	}
+
+	transcript := out.String()
+	for _, s := range []string{
+		"\r\x1b[KSubject [0/72]: ",
+		"\r\x1b[KSubject [9/72]: add serch",
+		"\r\x1b[KSubject [6/72]: add se",
+		"\r\x1b[KSubject [73/72]: " + long,
+		"Invalid subject: ",
+		"\r\x1b[KSubject [13/72]: repair search\r\n",
+	} {
+		if !strings.Contains(transcript, s) {
+			t.Errorf("transcript does not contain %q:\n%q", s, transcript)
+		}
+	}
+}
+
+func TestPrompter_Run_LiveSubjectOptions(t *testing.T) {
+	subject := strings.Repeat("e\u0301", 60)
+	var out strings.Builder
+	term := &rawTerminal{}
+	p := compose.Prompter{
+		In:             strings.NewReader("fix\n\n" + subject + "\r\n\n\n"),
+		Out:            &out,
+		RawMode:        term.makeRaw,
+		SubjectOptions: conventional.SubjectOptions{Length: conventional.LengthGraphemes},
+	}
+	msg, err := p.Run()
+	if err != nil {
+		t.Fatalf("Run() error = %v\n%q", err, out.String())
+	}
+	if string(msg.Subject) != subject || msg.SubjectOptions() != p.SubjectOptions {
+		t.Errorf("Run() = %+v", msg)
+	}
+	if err := msg.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "Subject [60/72]: "+subject+"\r\n") {
+		t.Errorf("transcript does not count graphemes:\n%q", out.String())
+	}
+}
+
+func TestPrompter_Run_LineSubjectOptions(t *testing.T) {
+	var out strings.Builder
+	p := compose.Prompter{
+		In:             strings.NewReader("fix\n\n" + strings.Repeat("\u65b0", 40) + "\n" + strings.Repeat("\u65b0", 30) + "\n\n\n\n"),
+		Out:            &out,
+		SubjectOptions: conventional.SubjectOptions{Length: conventional.LengthDisplayWidth},
+	}
+	if _, err := p.Run(); err != nil {
+		t.Fatalf("Run() error = %v\n%s", err, out.String())
+	}
+	for _, s := range []string{"Subject (max 72 columns): ", "Invalid subject (80/72)", "60/72 columns"} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("transcript does not contain %q:\n%s", s, out.String())
+		}
+	}
+}
+
+func TestPrompter_Run_LiveInterrupt(t *testing.T) {
+	term := &rawTerminal{}
+	p := compose.Prompter{In: strings.NewReader("fix\n\nadd\x03"), Out: io.Discard, RawMode: term.makeRaw}
+	if _, err := p.Run(); !stderrors.Is(err, compose.ErrInterrupted) {
+		t.Errorf("Run() error = %v, want ErrInterrupted", err)
+	}
+	if term.restored != 1 {
+		t.Errorf("raw mode restored %d times, want once", term.restored)
+	}
+
+	p = compose.Prompter{In: strings.NewReader("fix\n\nadd"), Out: io.Discard, RawMode: term.makeRaw}
+	if _, err := p.Run(); !stderrors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Run() error = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
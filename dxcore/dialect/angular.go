@@ -0,0 +1,146 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package dialect
+
+import (
+	"regexp"
+	"strings"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+var (
+	// angularHeaderRegexp splits an Angular header into its type, in any
+	// case, the optional scope and the remainder starting at the optional
+	// breaking marker.
+	angularHeaderRegexp = regexp.MustCompile(`^([A-Za-z]+)(?:\(([^)]*)\))?(!?:.*)$`)
+
+	// angularScopeInvalidRegexp matches the characters Angular scopes used
+	// that Conventional Commits scopes do not allow, such as the "$" of
+	// "$http".
+	angularScopeInvalidRegexp = regexp.MustCompile(`[^a-z0-9._/,-]+`)
+
+	// angularBreakingRegexp matches the start of an Angular breaking change
+	// section, which MAY appear anywhere in the body.
+	angularBreakingRegexp = regexp.MustCompile(`^BREAKING[ -]CHANGES?:\s*(.*)$`)
+
+	// angularIssueRegexp matches an Angular issue footer written without a
+	// colon, such as "Closes #12" or "Fixes #3, acme/api#4".
+	angularIssueRegexp = regexp.MustCompile(`(?i)^(close[sd]?|fix(?:e[sd])?|resolve[sd]?|refs?)\s+((?:[\w.-]+/[\w.-]+)?#\d+(?:\s*,\s*(?:[\w.-]+/[\w.-]+)?#\d+)*)$`)
+)
+
+// Angular is the dialect of the original Angular (and Karma) commit
+// guidelines that preceded Conventional Commits. In addition to
+// Conventional Commits messages it accepts:
+//
+//   - types in any case ("FEAT(core): ..." or "Fix: ...")
+//   - scopes with characters Conventional Commits does not allow; they are
+//     lowercased and stripped of such characters ("$http" becomes "http"),
+//     and the catch-all scope "*" is dropped
+//   - a "BREAKING CHANGE:" section anywhere in the body, running up to the
+//     next blank line; it becomes a BREAKING CHANGE trailer and marks the
+//     message as breaking
+//   - issue footers without a colon ("Closes #12", "Fixes #3, #4"); they
+//     become Closes, Fixes and Refs trailers
+//
+// A breaking change section too long for a trailer value is kept in the
+// body, without its "BREAKING CHANGE:" label, and the message is still
+// marked as breaking.
+var Angular Dialect = angularDialect{}
+
+type angularDialect struct{}
+
+func (angularDialect) Name() string { return "angular" }
+
+func (angularDialect) Parse(raw string) (conventional.Message, error) {
+	lines := strings.Split(strings.ReplaceAll(strings.TrimSpace(raw), "\r\n", "\n"), "\n")
+	if m := angularHeaderRegexp.FindStringSubmatch(lines[0]); m != nil {
+		lines[0] = strings.ToLower(m[1])
+		if scope := angularScope(m[2]); scope != "" {
+			lines[0] += "(" + scope + ")"
+		}
+		lines[0] += m[3]
+	}
+
+	var (
+		kept     = []string{lines[0]}
+		footers  []conventional.Trailer
+		breaking bool
+	)
+	for i := 1; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if m := angularIssueRegexp.FindStringSubmatch(line); m != nil {
+			footers = append(footers, conventional.Trailer{Key: angularIssueKey(m[1]), Value: m[2]})
+			continue
+		}
+
+		m := angularBreakingRegexp.FindStringSubmatch(line)
+		if m == nil {
+			kept = append(kept, lines[i])
+			continue
+		}
+		breaking = true
+		section := []string{m[1]}
+		for i+1 < len(lines) {
+			next := strings.TrimSpace(lines[i+1])
+			if next == "" || angularIssueRegexp.MatchString(next) {
+				break
+			}
+			section = append(section, next)
+			i++
+		}
+		tr := conventional.Trailer{
+			Key:   conventional.BreakingChangeTrailerKey,
+			Value: strings.TrimSpace(strings.Join(section, " ")),
+		}
+		if tr.Validate() == nil {
+			footers = append(footers, tr)
+		} else {
+			kept = append(kept, section...)
+		}
+	}
+
+	msg, err := conventional.ParseMessage(strings.Join(kept, "\n"))
+	if err != nil {
+		return conventional.Message{}, err
+	}
+	msg.Breaking = msg.Breaking || breaking
+	msg.Trailers = append(footers, msg.Trailers...)
+	if err := msg.Validate(); err != nil {
+		return conventional.Message{}, err
+	}
+	return msg, nil
+}
+
+// angularScope converts an Angular scope into a Conventional Commits scope,
+// or returns "" when nothing usable remains.
+func angularScope(s string) string {
+	s = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), " ", "-")
+	return strings.Trim(angularScopeInvalidRegexp.ReplaceAllString(s, ""), ",")
+}
+
+// angularIssueKey returns the trailer key for an Angular issue keyword.
+func angularIssueKey(keyword string) string {
+	switch k := strings.ToLower(keyword); {
+	case strings.HasPrefix(k, "fix"):
+		return conventional.FixesTrailerKey
+	case strings.HasPrefix(k, "ref"):
+		return conventional.RefsTrailerKey
+	default:
+		return conventional.ClosesTrailerKey
+	}
+}
@@ -0,0 +1,121 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package dialect_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/dialect"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+func TestAngular_Parse(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		typ      conventional.Type
+		scope    conventional.Scope
+		body     conventional.Body
+		breaking bool
+		trailers []conventional.Trailer
+	}{
+		{
+			name: "conventional",
+			raw:  "fix(core): handle nil\n\nSigned-off-by: Jane <jane@example.com>",
+			typ:  conventional.Fix, scope: "core",
+			trailers: []conventional.Trailer{{Key: "Signed-off-by", Value: "Jane <jane@example.com>"}},
+		},
+		{
+			name: "uppercase_type",
+			raw:  "FEAT(compiler): add i18n",
+			typ:  conventional.Feat, scope: "compiler",
+		},
+		{
+			name: "catch_all_scope",
+			raw:  "Docs(*): update guide",
+			typ:  conventional.Docs,
+		},
+		{
+			name: "closes_without_colon",
+			raw:  "fix($http): drop empty headers\n\nCloses #12\nFixes #3, angular/angular.js#4",
+			typ:  conventional.Fix, scope: "http",
+			trailers: []conventional.Trailer{
+				{Key: "Closes", Value: "#12"},
+				{Key: "Fixes", Value: "#3, angular/angular.js#4"},
+			},
+		},
+		{
+			name: "breaking_in_body",
+			raw: "feat(router): rename $route events\n\n" +
+				"BREAKING CHANGE: $routeChangeStart is now $locationChangeStart.\n" +
+				"Update listeners accordingly.\n\n" +
+				"Some more context after the section.\n\n" +
+				"Closes #99",
+			typ: conventional.Feat, scope: "router",
+			body:     "Some more context after the section.",
+			breaking: true,
+			trailers: []conventional.Trailer{
+				{Key: "BREAKING CHANGE", Value: "$routeChangeStart is now $locationChangeStart. Update listeners accordingly."},
+				{Key: "Closes", Value: "#99"},
+			},
+		},
+		{
+			name: "long_breaking_section_stays_in_body",
+			raw:  "fix: x\n\nBREAKING CHANGE: " + strings.Repeat("word ", 60),
+			typ:  conventional.Fix, body: conventional.Body(strings.TrimSpace(strings.Repeat("word ", 60))),
+			breaking: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := dialect.Angular.Parse(tt.raw)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if m.Type != tt.typ || m.Scope != tt.scope || m.Breaking != tt.breaking {
+				t.Errorf("Parse() = {%v %q %v}, want {%v %q %v}", m.Type, m.Scope, m.Breaking, tt.typ, tt.scope, tt.breaking)
+			}
+			if m.Body != tt.body {
+				t.Errorf("Parse() Body = %q, want %q", m.Body, tt.body)
+			}
+			if !reflect.DeepEqual(m.Trailers, tt.trailers) {
+				t.Errorf("Parse() Trailers = %v, want %v", m.Trailers, tt.trailers)
+			}
+		})
+	}
+}
+
+func TestAngular_IssueRefs(t *testing.T) {
+	m, err := dialect.Angular.Parse("fix: crash\n\ncloses #1\nRefs #2")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := m.IssueRefs(); len(got) != 2 || got[0].Number != 1 || got[1].Number != 2 {
+		t.Errorf("IssueRefs() = %v", got)
+	}
+}
+
+func TestAngular_ParseInvalid(t *testing.T) {
+	for _, raw := range []string{"", "update readme", "unknown(x): y"} {
+		if _, err := dialect.Angular.Parse(raw); err == nil {
+			t.Errorf("Parse(%q) expected error", raw)
+		}
+	}
+}
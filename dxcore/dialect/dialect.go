@@ -0,0 +1,117 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package dialect lets dxrel read commit messages written in conventions
+// other than Conventional Commits. A Dialect converts a raw commit message
+// into a conventional.Message, so that everything downstream (version
+// computation, changelogs, linting) works on a single model regardless of how
+// a repository writes its commits.
+//
+// Dialects are registered by name; a repository selects one in its
+// configuration and the engine looks it up with Lookup.
+package dialect
+
+import (
+	"sort"
+	"sync"
+
+	"dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+// Dialect parses commit messages written in one convention.
+type Dialect interface {
+	// Name returns the identifier used to select the dialect in
+	// configuration, for example "conventional" or "gitmoji".
+	Name() string
+
+	// Parse converts a raw commit message into a conventional.Message. It
+	// returns an error if raw does not follow the dialect.
+	Parse(raw string) (conventional.Message, error)
+}
+
+// Bumper is an optional interface for dialects whose conventions determine
+// the version increment directly, rather than through the conventional.Type
+// of the parsed message. Bump reports false when the dialect has no opinion
+// about raw, in which case the engine's type-based mapping applies.
+type Bumper interface {
+	Bump(raw string) (change.Bump, bool)
+}
+
+// Conventional is the Conventional Commits dialect. Parse is
+// conventional.ParseMessage.
+var Conventional Dialect = conventionalDialect{}
+
+type conventionalDialect struct{}
+
+func (conventionalDialect) Name() string { return "conventional" }
+
+func (conventionalDialect) Parse(raw string) (conventional.Message, error) {
+	return conventional.ParseMessage(raw)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Dialect{}
+)
+
+func init() {
+	registry[Conventional.Name()] = Conventional
+	registry[Gitmoji.Name()] = Gitmoji
+	registry[Angular.Name()] = Angular
+}
+
+// Register makes d available to Lookup under d.Name(), replacing any dialect
+// registered under the same name. It is safe for concurrent use.
+func Register(d Dialect) error {
+	if d == nil || d.Name() == "" {
+		return &errors.ValidationError{
+			Code:   errors.CodeRequired,
+			Type:   "Dialect",
+			Field:  "Name",
+			Reason: "dialect must have a non-empty name",
+		}
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[d.Name()] = d
+	return nil
+}
+
+// Lookup returns the dialect registered under name. An empty name selects
+// Conventional.
+func Lookup(name string) (Dialect, bool) {
+	if name == "" {
+		return Conventional, true
+	}
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	d, ok := registry[name]
+	return d, ok
+}
+
+// Names returns the names of all registered dialects in sorted order.
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
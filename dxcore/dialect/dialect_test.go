@@ -0,0 +1,89 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package dialect_test
+
+import (
+	stderrors "errors"
+	"reflect"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/dialect"
+	"dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+type upperDialect struct{}
+
+func (upperDialect) Name() string { return "test-upper" }
+
+func (upperDialect) Parse(raw string) (conventional.Message, error) {
+	return conventional.ParseMessage(raw)
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+		ok   bool
+	}{
+		{"", "conventional", true},
+		{"conventional", "conventional", true},
+		{"gitmoji", "gitmoji", true},
+		{"angular", "angular", true},
+		{"unknown", "", false},
+	}
+	for _, tt := range tests {
+		d, ok := dialect.Lookup(tt.name)
+		if ok != tt.ok {
+			t.Errorf("Lookup(%q) ok = %v, want %v", tt.name, ok, tt.ok)
+			continue
+		}
+		if ok && d.Name() != tt.want {
+			t.Errorf("Lookup(%q) = %q, want %q", tt.name, d.Name(), tt.want)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	if err := dialect.Register(upperDialect{}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if _, ok := dialect.Lookup("test-upper"); !ok {
+		t.Error("Lookup() did not find registered dialect")
+	}
+	if err := dialect.Register(nil); !stderrors.Is(err, errors.CodeRequired) {
+		t.Errorf("Register(nil) error = %v, want %s", err, errors.CodeRequired)
+	}
+
+	want := []string{"angular", "conventional", "gitmoji", "test-upper"}
+	if got := dialect.Names(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+}
+
+func TestConventional(t *testing.T) {
+	m, err := dialect.Conventional.Parse("fix(api): handle nil")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if m.Type != conventional.Fix || m.Scope != "api" {
+		t.Errorf("Parse() = %+v", m)
+	}
+	if _, err := dialect.Conventional.Parse("✨ add search"); err == nil {
+		t.Error("Parse() expected error for gitmoji header")
+	}
+}
@@ -0,0 +1,177 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package dialect
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+// Emoji describes one gitmoji and its meaning in the conventional model.
+type Emoji struct {
+	// Emoji is the Unicode form, for example "✨". Variation selectors are
+	// ignored when matching.
+	Emoji string
+
+	// Code is the shortcode form, for example ":sparkles:".
+	Code string
+
+	// Type is the conventional type the gitmoji corresponds to.
+	Type conventional.Type
+
+	// Bump is the version increment the gitmoji requests.
+	Bump change.Bump
+}
+
+// GitmojiTable lists the gitmojis understood by the Gitmoji dialect. Emojis
+// that mark breaking changes map to BumpMajor and produce a message with
+// Breaking set.
+var GitmojiTable = []Emoji{
+	{"💥", ":boom:", conventional.Feat, change.BumpMajor},
+	{"✨", ":sparkles:", conventional.Feat, change.BumpMinor},
+	{"🐛", ":bug:", conventional.Fix, change.BumpPatch},
+	{"🚑", ":ambulance:", conventional.Fix, change.BumpPatch},
+	{"🩹", ":adhesive_bandage:", conventional.Fix, change.BumpPatch},
+	{"🔒", ":lock:", conventional.Fix, change.BumpPatch},
+	{"⚡", ":zap:", conventional.Perf, change.BumpPatch},
+	{"⏪", ":rewind:", conventional.Revert, change.BumpPatch},
+	{"⬆", ":arrow_up:", conventional.Build, change.BumpPatch},
+	{"⬇", ":arrow_down:", conventional.Build, change.BumpPatch},
+	{"📝", ":memo:", conventional.Docs, change.BumpNone},
+	{"🎨", ":art:", conventional.Style, change.BumpNone},
+	{"💄", ":lipstick:", conventional.Style, change.BumpNone},
+	{"🚨", ":rotating_light:", conventional.Style, change.BumpNone},
+	{"♻", ":recycle:", conventional.Refactor, change.BumpNone},
+	{"🔥", ":fire:", conventional.Refactor, change.BumpNone},
+	{"✅", ":white_check_mark:", conventional.Test, change.BumpNone},
+	{"🧪", ":test_tube:", conventional.Test, change.BumpNone},
+	{"👷", ":construction_worker:", conventional.CI, change.BumpNone},
+	{"💚", ":green_heart:", conventional.CI, change.BumpNone},
+	{"📦", ":package:", conventional.Build, change.BumpNone},
+	{"🔨", ":hammer:", conventional.Build, change.BumpNone},
+	{"🔧", ":wrench:", conventional.Chore, change.BumpNone},
+	{"🔖", ":bookmark:", conventional.Chore, change.BumpNone},
+	{"🚀", ":rocket:", conventional.Chore, change.BumpNone},
+}
+
+// gitmojiHeaderRegexp splits a gitmoji header into the emoji or shortcode,
+// an optional scope, an optional breaking marker and the subject:
+//
+//	✨ add search
+//	:bug: (api): fix crash
+//	💥 (cli)!: drop flag
+var gitmojiHeaderRegexp = regexp.MustCompile(`^(:[a-z0-9_+-]+:|\S+?)\s*(?:\(([^)]+)\))?(!)?(?::\s*|\s+)(.+)$`)
+
+// Gitmoji is the gitmoji dialect (https://gitmoji.dev). It accepts headers
+// that start with a gitmoji in Unicode or shortcode form, optionally
+// followed by a parenthesized scope and a colon:
+//
+//	✨ add search
+//	:sparkles: (api): add search
+//
+// The body and trailers follow Conventional Commits rules, so BREAKING
+// CHANGE footers are honored in addition to the 💥 gitmoji.
+var Gitmoji Dialect = gitmojiDialect{}
+
+type gitmojiDialect struct{}
+
+func (gitmojiDialect) Name() string { return "gitmoji" }
+
+func (d gitmojiDialect) Parse(raw string) (conventional.Message, error) {
+	e, scope, breaking, subject, rest, err := splitGitmoji(raw)
+	if err != nil {
+		return conventional.Message{}, err
+	}
+
+	header := e.Type.String()
+	if scope != "" {
+		header += "(" + scope + ")"
+	}
+	if breaking || e.Bump == change.BumpMajor {
+		header += "!"
+	}
+	header += ": " + subject
+
+	return conventional.ParseMessage(header + rest)
+}
+
+// Bump implements Bumper: the gitmoji decides the increment, and a breaking
+// marker or BREAKING CHANGE footer raises it to BumpMajor.
+func (d gitmojiDialect) Bump(raw string) (change.Bump, bool) {
+	e, _, _, _, _, err := splitGitmoji(raw)
+	if err != nil {
+		return change.BumpNone, false
+	}
+	if m, err := d.Parse(raw); err == nil && m.Breaking {
+		return change.BumpMajor, true
+	}
+	return e.Bump, true
+}
+
+// LookupGitmoji returns the GitmojiTable entry for an emoji or shortcode.
+func LookupGitmoji(s string) (Emoji, bool) {
+	s = stripVariationSelectors(s)
+	for _, e := range GitmojiTable {
+		if s == e.Emoji || s == e.Code {
+			return e, true
+		}
+	}
+	return Emoji{}, false
+}
+
+// splitGitmoji parses the header of a gitmoji message and returns its parts
+// together with the remainder of the message (starting at the first line
+// break, or empty).
+func splitGitmoji(raw string) (e Emoji, scope string, breaking bool, subject, rest string, err error) {
+	raw = strings.TrimSpace(strings.ReplaceAll(raw, "\r\n", "\n"))
+	header := raw
+	if i := strings.IndexByte(raw, '\n'); i >= 0 {
+		header, rest = raw[:i], raw[i:]
+	}
+
+	m := gitmojiHeaderRegexp.FindStringSubmatch(strings.TrimSpace(header))
+	if m == nil {
+		return Emoji{}, "", false, "", "", &errors.ValidationError{
+			Code:   errors.CodeFormat,
+			Type:   conventional.Message{}.TypeName(),
+			Reason: fmt.Sprintf("invalid gitmoji header format: %q", header),
+			Value:  header,
+		}
+	}
+	e, ok := LookupGitmoji(m[1])
+	if !ok {
+		return Emoji{}, "", false, "", "", &errors.ValidationError{
+			Code:   errors.CodeOutOfRange,
+			Type:   conventional.Message{}.TypeName(),
+			Field:  "Type",
+			Reason: fmt.Sprintf("unknown gitmoji %q", m[1]),
+			Value:  m[1],
+		}
+	}
+	return e, m[2], m[3] == "!", m[4], rest, nil
+}
+
+// stripVariationSelectors removes U+FE0E and U+FE0F, which some editors add
+// after emoji such as "♻️".
+func stripVariationSelectors(s string) string {
+	return strings.NewReplacer("︎", "", "️", "").Replace(s)
+}
@@ -0,0 +1,114 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package dialect_test
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/dialect"
+	"dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+func TestGitmoji_Parse(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		typ      conventional.Type
+		scope    conventional.Scope
+		subject  conventional.Subject
+		breaking bool
+		bump     change.Bump
+	}{
+		{"unicode", "✨ add search", conventional.Feat, "", "add search", false, change.BumpMinor},
+		{"shortcode", ":bug: fix crash", conventional.Fix, "", "fix crash", false, change.BumpPatch},
+		{"scope", ":bug: (api): fix crash", conventional.Fix, "api", "fix crash", false, change.BumpPatch},
+		{"scope_no_space", "✨(cli): add flag", conventional.Feat, "cli", "add flag", false, change.BumpMinor},
+		{"variation_selector", "♻️ simplify parser", conventional.Refactor, "", "simplify parser", false, change.BumpNone},
+		{"boom", "💥 drop v1 API", conventional.Feat, "", "drop v1 API", true, change.BumpMajor},
+		{"bang", "🐛 (api)!: change error type", conventional.Fix, "api", "change error type", true, change.BumpMajor},
+		{"footer", "🐛 fix crash\n\nBREAKING CHANGE: new error type", conventional.Fix, "", "fix crash", true, change.BumpMajor},
+		{"dependency", "⬆️ upgrade yaml", conventional.Build, "", "upgrade yaml", false, change.BumpPatch},
+		{"docs", "📝 document flags", conventional.Docs, "", "document flags", false, change.BumpNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := dialect.Gitmoji.Parse(tt.raw)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.raw, err)
+			}
+			if m.Type != tt.typ || m.Scope != tt.scope || m.Subject != tt.subject || m.Breaking != tt.breaking {
+				t.Errorf("Parse(%q) = {%v %q %q %v}, want {%v %q %q %v}",
+					tt.raw, m.Type, m.Scope, m.Subject, m.Breaking, tt.typ, tt.scope, tt.subject, tt.breaking)
+			}
+			if err := m.Validate(); err != nil {
+				t.Errorf("Parse(%q).Validate() error = %v", tt.raw, err)
+			}
+
+			bump, ok := dialect.Gitmoji.(dialect.Bumper).Bump(tt.raw)
+			if !ok || bump != tt.bump {
+				t.Errorf("Bump(%q) = %v, %v, want %v, true", tt.raw, bump, ok, tt.bump)
+			}
+		})
+	}
+}
+
+func TestGitmoji_ParseInvalid(t *testing.T) {
+	for _, raw := range []string{
+		"",
+		"feat: add search",
+		":unknown: do something",
+		"🦄 add unicorn",
+		"✨",
+	} {
+		if _, err := dialect.Gitmoji.Parse(raw); err == nil {
+			t.Errorf("Parse(%q) expected error", raw)
+		}
+		if _, ok := dialect.Gitmoji.(dialect.Bumper).Bump(raw); ok {
+			t.Errorf("Bump(%q) reported a bump for an invalid message", raw)
+		}
+	}
+
+	_, err := dialect.Gitmoji.Parse(":unknown: do something")
+	var ve *errors.ValidationError
+	if !stderrors.As(err, &ve) || ve.Path() != "Message.Type" || ve.Code != errors.CodeOutOfRange {
+		t.Errorf("Parse(:unknown:) error = %v, want out_of_range at Message.Type", err)
+	}
+}
+
+func TestGitmojiTable(t *testing.T) {
+	seen := map[string]bool{}
+	for _, e := range dialect.GitmojiTable {
+		if seen[e.Emoji] || seen[e.Code] {
+			t.Errorf("duplicate gitmoji %s %s", e.Emoji, e.Code)
+		}
+		seen[e.Emoji], seen[e.Code] = true, true
+
+		if err := e.Type.Validate(); err != nil {
+			t.Errorf("%s: invalid type: %v", e.Code, err)
+		}
+		if err := e.Bump.Validate(); err != nil {
+			t.Errorf("%s: invalid bump: %v", e.Code, err)
+		}
+		if got, ok := dialect.LookupGitmoji(e.Code); !ok || got != e {
+			t.Errorf("LookupGitmoji(%q) = %v, %v", e.Code, got, ok)
+		}
+	}
+}
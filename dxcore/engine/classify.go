@@ -0,0 +1,234 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package engine
+
+import (
+	"regexp"
+	"strings"
+
+	"dirpx.dev/dxrel/dxcore/dialect"
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+var (
+	// mergeSummaryRegexp matches the summary lines that hosting services
+	// generate for merge commits:
+	//
+	//	Merge pull request #12 from owner/branch   (GitHub)
+	//	Merge branch 'feature' into 'main'         (GitLab)
+	mergeSummaryRegexp = regexp.MustCompile(`^Merge (?:pull request #\d+ from \S+|branch '[^']+' into '[^']+')`)
+
+	// squashBulletRegexp matches a bullet line in a squash-merge body and
+	// captures the text after the bullet marker.
+	squashBulletRegexp = regexp.MustCompile(`^[*-]\s+(.+)$`)
+)
+
+// ClassifyWith converts the commits of a range into entries according to
+// mode. Commits MUST be ordered oldest first, with the range tip last.
+//
+// ClassifyAll is equivalent to Classify. ClassifyFirstParent and
+// ClassifyPRTitle first reduce the commits to the first-parent chain (see
+// FirstParent). ClassifyPRTitle and ClassifySquash then derive messages from
+// merge titles and squash bodies respectively. An invalid mode is treated as
+// ClassifyAll.
+func ClassifyWith(commits []git.Commit, mode model.ClassificationMode) []Entry {
+	return ClassifyDialect(commits, mode, dialect.Conventional)
+}
+
+// ClassifyDialect is like ClassifyWith but parses commit messages, pull
+// request titles and squash bullets with d instead of the Conventional
+// Commits parser. A nil d selects dialect.Conventional.
+func ClassifyDialect(commits []git.Commit, mode model.ClassificationMode, d dialect.Dialect) []Entry {
+	if d == nil {
+		d = dialect.Conventional
+	}
+	switch mode {
+	case model.ClassifyFirstParent:
+		return classify(FirstParent(commits), d)
+	case model.ClassifyPRTitle:
+		mainline := FirstParent(commits)
+		entries := make([]Entry, 0, len(mainline))
+		for _, c := range mainline {
+			entries = append(entries, classifyPRTitle(c, d))
+		}
+		return entries
+	case model.ClassifySquash:
+		entries := make([]Entry, 0, len(commits))
+		for _, c := range commits {
+			entries = append(entries, classifySquash(c, d)...)
+		}
+		return entries
+	default:
+		return classify(commits, d)
+	}
+}
+
+// FirstParent returns the commits reachable from the range tip (the last
+// element of commits) by following first parents, in their original order.
+// The walk stops at the first parent that is not part of commits, which is
+// normally the range boundary.
+func FirstParent(commits []git.Commit) []git.Commit {
+	if len(commits) == 0 {
+		return nil
+	}
+
+	byHash := make(map[git.Hash]int, len(commits))
+	for i, c := range commits {
+		byHash[c.Hash] = i
+	}
+
+	keep := make([]bool, len(commits))
+	for i, ok := len(commits)-1, true; ok && !keep[i]; {
+		keep[i] = true
+		if len(commits[i].Parents) == 0 {
+			break
+		}
+		i, ok = byHash[commits[i].Parents[0]]
+	}
+
+	result := make([]git.Commit, 0, len(commits))
+	for i, c := range commits {
+		if keep[i] {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// PRTitle returns the pull request title recorded in a merge commit: the
+// first non-blank line following a "Merge pull request #N from ..." or
+// "Merge branch 'x' into 'y'" summary. It reports false when c is not such a
+// merge commit or carries no title.
+func PRTitle(c git.Commit) (string, bool) {
+	lines := strings.Split(c.Message, "\n")
+	if !mergeSummaryRegexp.MatchString(strings.TrimSpace(lines[0])) {
+		return "", false
+	}
+	for _, line := range lines[1:] {
+		if title := strings.TrimSpace(line); title != "" {
+			return title, true
+		}
+	}
+	return "", false
+}
+
+// SquashMessages expands a squash-merge commit into the Conventional Commit
+// messages listed in its body as bullet lines ("* feat: add search"). Lines
+// following a bullet up to the next bullet are treated as that message's body
+// and footers, so a "BREAKING CHANGE:" footer is attributed to the bullet it
+// belongs to. Bullets that are not valid Conventional Commit headers are
+// skipped together with the lines that follow them. SquashMessages returns
+// nil when the body lists no such bullet.
+func SquashMessages(c git.Commit) []conventional.Message {
+	var messages []conventional.Message
+	for _, s := range squashed(c, dialect.Conventional) {
+		messages = append(messages, s.msg)
+	}
+	return messages
+}
+
+// squashedMessage is one message listed in a squash-merge body, together with
+// the text it was parsed from.
+type squashedMessage struct {
+	raw string
+	msg conventional.Message
+}
+
+// squashed implements SquashMessages for an arbitrary dialect: bullets are
+// collected when their text alone parses with d.
+func squashed(c git.Commit, d dialect.Dialect) []squashedMessage {
+	lines := strings.Split(c.Message, "\n")
+
+	var (
+		messages []squashedMessage
+		current  []string
+	)
+	flush := func() {
+		if len(current) == 0 {
+			return
+		}
+		raw := strings.Join(current, "\n")
+		msg, err := d.Parse(raw)
+		if err != nil {
+			// Fall back to the bullet header alone.
+			raw = current[0]
+			msg, err = d.Parse(raw)
+		}
+		if err == nil {
+			messages = append(messages, squashedMessage{raw: raw, msg: msg})
+		}
+		current = nil
+	}
+
+	for _, line := range lines[1:] {
+		if m := squashBulletRegexp.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			// Every bullet starts a new squashed commit; only those with a
+			// header in the dialect are collected.
+			flush()
+			if _, err := d.Parse(m[1]); err == nil {
+				current = []string{m[1]}
+			}
+			continue
+		}
+		if current != nil {
+			current = append(current, line)
+		}
+	}
+	flush()
+
+	return messages
+}
+
+// classifyPRTitle classifies a mainline commit, preferring the pull request
+// title for merge commits.
+func classifyPRTitle(c git.Commit, d dialect.Dialect) Entry {
+	if title, ok := PRTitle(c); ok {
+		if msg, err := d.Parse(title); err == nil {
+			return newEntryFromMessage(c, msg, bumpWith(d, title, msg), false)
+		}
+	}
+	return NewEntryWith(c, d)
+}
+
+// classifySquash classifies a commit, expanding squash bodies into virtual
+// entries when present.
+func classifySquash(c git.Commit, d dialect.Dialect) []Entry {
+	messages := squashed(c, d)
+	if len(messages) == 0 {
+		return []Entry{NewEntryWith(c, d)}
+	}
+	entries := make([]Entry, 0, len(messages))
+	for _, s := range messages {
+		entries = append(entries, newEntryFromMessage(c, s.msg, bumpWith(d, s.raw, s.msg), true))
+	}
+	return entries
+}
+
+// newEntryFromMessage builds an entry for c whose message was derived from
+// text other than the commit header.
+func newEntryFromMessage(c git.Commit, msg conventional.Message, bump change.Bump, virtual bool) Entry {
+	return Entry{
+		Commit:       c,
+		Message:      msg,
+		Conventional: true,
+		Virtual:      virtual,
+		Bump:         bump,
+	}
+}
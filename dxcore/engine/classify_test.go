@@ -0,0 +1,189 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package engine_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/dialect"
+	"dirpx.dev/dxrel/dxcore/engine"
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+func withParents(c git.Commit, parents ...string) git.Commit {
+	for _, p := range parents {
+		c.Parents = append(c.Parents, hashOf(p))
+	}
+	return c
+}
+
+// mergeHistory models a GitHub merge-commit history:
+//
+//	base -- m1 ------- m2   (mainline)
+//	    \   /         /
+//	     b1          b2 -- b3
+func mergeHistory() []git.Commit {
+	return []git.Commit{
+		withParents(commit("b100", "feat: branch feature"), "0000"),
+		withParents(commit("a100", "Merge pull request #1 from acme/one\n\nfeat: add search"), "0000", "b100"),
+		withParents(commit("b200", "fix: branch fix"), "a100"),
+		withParents(commit("b300", "feat!: branch breaking"), "b200"),
+		withParents(commit("a200", "Merge pull request #2 from acme/two\n\nfix: correct ranking"), "a100", "b300"),
+	}
+}
+
+func TestFirstParent(t *testing.T) {
+	got := engine.FirstParent(mergeHistory())
+	var seeds []string
+	for _, c := range got {
+		seeds = append(seeds, string(c.Hash)[:4])
+	}
+	if want := []string{"a100", "a200"}; !reflect.DeepEqual(seeds, want) {
+		t.Errorf("FirstParent() = %v, want %v", seeds, want)
+	}
+	if engine.FirstParent(nil) != nil {
+		t.Error("FirstParent(nil) should be nil")
+	}
+}
+
+func TestPRTitle(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+		ok      bool
+	}{
+		{"github", "Merge pull request #12 from acme/x\n\nfeat: add search", "feat: add search", true},
+		{"gitlab", "Merge branch 'x' into 'main'\n\nfix: typo\n\nSee merge request acme/p!3", "fix: typo", true},
+		{"no_title", "Merge pull request #12 from acme/x", "", false},
+		{"not_merge", "feat: add search", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := engine.PRTitle(commit("aaaa", tt.message))
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("PRTitle() = %q, %v, want %q, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestSquashMessages(t *testing.T) {
+	c := commit("aaaa", strings.Join([]string{
+		"feat: search (#12)",
+		"",
+		"* feat: add search",
+		"",
+		"* fix: typo in index",
+		"",
+		"* feat(api): drop v1 search",
+		"",
+		"BREAKING CHANGE: v1 endpoint removed",
+		"",
+		"* wip",
+	}, "\n"))
+
+	msgs := engine.SquashMessages(c)
+	if len(msgs) != 3 {
+		t.Fatalf("SquashMessages() returned %d messages, want 3", len(msgs))
+	}
+	if msgs[0].Subject != "add search" || msgs[1].Subject != "typo in index" {
+		t.Errorf("unexpected subjects: %q, %q", msgs[0].Subject, msgs[1].Subject)
+	}
+	if msgs[1].Breaking || !msgs[2].Breaking {
+		t.Errorf("BREAKING CHANGE attributed to wrong bullet: %v, %v", msgs[1].Breaking, msgs[2].Breaking)
+	}
+
+	if got := engine.SquashMessages(commit("bbbb", "feat: plain")); got != nil {
+		t.Errorf("SquashMessages() = %v, want nil", got)
+	}
+}
+
+func TestClassifyWith(t *testing.T) {
+	squash := []git.Commit{
+		commit("aaaa", "feat: search (#12)\n\n* fix: a\n\n* fix: b"),
+		commit("bbbb", "docs: readme"),
+	}
+
+	tests := []struct {
+		name    string
+		commits []git.Commit
+		mode    model.ClassificationMode
+		entries int
+		bump    change.Bump
+	}{
+		{"all_counts_branch_commits", mergeHistory(), model.ClassifyAll, 5, change.BumpMajor},
+		{"first_parent_ignores_branches", mergeHistory(), model.ClassifyFirstParent, 2, change.BumpNone},
+		{"pr_title", mergeHistory(), model.ClassifyPRTitle, 2, change.BumpMinor},
+		{"squash_expands_body", squash, model.ClassifySquash, 3, change.BumpPatch},
+		{"squash_header_only_without_mode", squash, model.ClassifyAll, 2, change.BumpMinor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := engine.ClassifyWith(tt.commits, tt.mode)
+			if len(entries) != tt.entries {
+				t.Errorf("ClassifyWith() returned %d entries, want %d", len(entries), tt.entries)
+			}
+			if got := engine.MaxBump(entries); got != tt.bump {
+				t.Errorf("MaxBump() = %v, want %v", got, tt.bump)
+			}
+		})
+	}
+}
+
+func TestCancelReverts_VirtualEntries(t *testing.T) {
+	entries := engine.ClassifyWith([]git.Commit{
+		commit("aaaa", "feat: search (#12)\n\n* feat: a\n\n* feat!: b"),
+		commit("bbbb", "fix: c"),
+		commit("cccc", "revert: search\n\nThis reverts commit "+string(hashOf("aaaa"))+"."),
+	}, model.ClassifySquash)
+
+	got := engine.CancelReverts(entries)
+	if len(got) != 1 || got[0].Commit.Hash != hashOf("bbbb") {
+		t.Errorf("CancelReverts() = %v, want only bbbb", hashes(got))
+	}
+}
+
+func TestClassifyDialect_Gitmoji(t *testing.T) {
+	commits := []git.Commit{
+		commit("aaaa", "✨ add search"),
+		commit("bbbb", ":arrow_up: bump yaml to v3.0.1"),
+		commit("cccc", "feat: not gitmoji"),
+		commit("dddd", "Update deps (#3)\n\n* :bug: (api): fix crash\n* 📝 docs"),
+	}
+
+	entries := engine.ClassifyDialect(commits, model.ClassifySquash, dialect.Gitmoji)
+	var got []change.Bump
+	for _, e := range entries {
+		got = append(got, e.Bump)
+	}
+	want := []change.Bump{change.BumpMinor, change.BumpPatch, change.BumpNone, change.BumpPatch, change.BumpNone}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ClassifyDialect() bumps = %v, want %v", got, want)
+	}
+	if entries[2].Conventional {
+		t.Errorf("ClassifyDialect() parsed %q with the gitmoji dialect", commits[2].Message)
+	}
+	if entries[3].Message.Scope != "api" || !entries[3].Virtual {
+		t.Errorf("ClassifyDialect() squash entry = %+v, want virtual api entry", entries[3])
+	}
+}
@@ -0,0 +1,178 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package engine
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+// Contributor is one person credited in a release, as listed in the
+// contributors section of a changelog.
+type Contributor struct {
+	// Name and Email identify the person, canonicalized with the mailmap
+	// of ContributorOptions. Name is the first one seen for the person in
+	// the release.
+	Name  string `json:"name" yaml:"name"`
+	Email string `json:"email" yaml:"email"`
+
+	// Commits is the number of commits of the release the person
+	// authored, co-authored or committed. A commit counts once per person
+	// regardless of how many of these roles they had in it.
+	Commits int `json:"commits" yaml:"commits"`
+
+	// FirstTime reports that the person does not appear in the history
+	// preceding the release.
+	FirstTime bool `json:"firstTime" yaml:"firstTime"`
+}
+
+// Contributors is the contributors section of a release, ordered by
+// descending commit count, then by name.
+type Contributors []Contributor
+
+// FirstTime returns the first-time contributors, in order. Changelog
+// templates can list them with {{range .Contributors.FirstTime}}.
+func (cs Contributors) FirstTime() Contributors {
+	var out Contributors
+	for _, c := range cs {
+		if c.FirstTime {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// ContributorOptions configures ContributorsOf.
+type ContributorOptions struct {
+	// Mailmap canonicalizes identities before they are compared, so that a
+	// person who committed under several names or addresses is counted once.
+	// The zero Mailmap leaves identities unchanged.
+	Mailmap git.Mailmap
+
+	// History holds the commits that precede the release, used to tell
+	// first-time contributors apart. When it is empty, every contributor is
+	// a first-time contributor.
+	History []git.Commit
+
+	// SkipCommitters credits only authors and co-authors. Committers are
+	// often bots or the hosting service (for example, commits made in the
+	// GitHub web interface are committed by "GitHub <noreply@github.com>").
+	SkipCommitters bool
+}
+
+// ContributorsOf returns the people who contributed to a release made of
+// commits: their authors, the people named in their Co-authored-by
+// trailers and, unless opts.SkipCommitters is set, their committers.
+// People are identified by email address, compared case-insensitively
+// after applying opts.Mailmap; identities without an address are
+// identified by name.
+func ContributorsOf(commits []git.Commit, opts ContributorOptions) Contributors {
+	previous := make(map[string]bool)
+	for _, c := range opts.History {
+		for _, p := range commitPeople(c, opts) {
+			previous[p.key()] = true
+		}
+	}
+
+	index := make(map[string]int)
+	var out Contributors
+	for _, c := range commits {
+		seen := make(map[string]bool)
+		for _, p := range commitPeople(c, opts) {
+			key := p.key()
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			i, ok := index[key]
+			if !ok {
+				i = len(out)
+				index[key] = i
+				out = append(out, Contributor{Name: p.name, Email: p.email, FirstTime: !previous[key]})
+			}
+			out[i].Commits++
+		}
+	}
+
+	slices.SortStableFunc(out, func(a, b Contributor) int {
+		return cmp.Or(
+			cmp.Compare(b.Commits, a.Commits),
+			cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)),
+			cmp.Compare(strings.ToLower(a.Email), strings.ToLower(b.Email)),
+		)
+	})
+	return out
+}
+
+// identity is a canonicalized name and email address.
+type identity struct {
+	name, email string
+}
+
+// key returns the value people are told apart by.
+func (id identity) key() string {
+	if id.email != "" {
+		return "<" + strings.ToLower(id.email) + ">"
+	}
+	return strings.ToLower(id.name)
+}
+
+// commitPeople returns the canonicalized identities credited for c.
+func commitPeople(c git.Commit, opts ContributorOptions) []identity {
+	var people []identity
+	add := func(name, email string) {
+		if name == "" && email == "" {
+			return
+		}
+		name, email = opts.Mailmap.Lookup(name, email)
+		people = append(people, identity{name: name, email: email})
+	}
+
+	add(c.Author.Name, c.Author.Email)
+	for _, p := range coAuthors(c.Message) {
+		add(p.Name, p.Email)
+	}
+	if !opts.SkipCommitters {
+		add(c.Committer.Name, c.Committer.Email)
+	}
+	return people
+}
+
+// coAuthors returns the identities from the Co-authored-by trailers of the
+// commit message raw. The trailer block is found with
+// conventional.ParseTrailers, so that messages which are not Conventional
+// Commits are read the same way as those that are.
+func coAuthors(raw string) []conventional.Person {
+	trailers, err := conventional.ParseTrailers(raw, conventional.DefaultTrailerConfig)
+	if err != nil {
+		return nil
+	}
+	var people []conventional.Person
+	for _, tr := range trailers {
+		if !strings.EqualFold(tr.Key, conventional.CoAuthoredByTrailerKey) {
+			continue
+		}
+		if p, err := conventional.ParsePerson(tr.Value); err == nil {
+			people = append(people, p)
+		}
+	}
+	return people
+}
@@ -0,0 +1,118 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package engine_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/engine"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+func authored(seed, message, author, email string) git.Commit {
+	c := commit(seed, message)
+	c.Author = git.Signature{Name: author, Email: email}
+	c.Committer = git.Signature{Name: "GitHub", Email: "noreply@github.com"}
+	return c
+}
+
+func TestContributorsOf(t *testing.T) {
+	mailmap, _ := git.ParseMailmap("Jane Doe <jane@example.com> <jane@laptop.local>\n")
+	history := []git.Commit{
+		authored("0001", "feat: old", "Bob", "bob@example.com"),
+	}
+	commits := []git.Commit{
+		authored("aaaa", "feat: a\n\nCo-authored-by: Ann <ann@example.com>", "Jane Doe", "jane@example.com"),
+		authored("bbbb", "fix: b", "jane", "JANE@laptop.local"),
+		authored("cccc", "Merge something\n\nCo-authored-by: Bob <BOB@example.com>\nCo-authored-by: Ann <ann@example.com>", "Ann", "ann@example.com"),
+		authored("dddd", "docs: d\n\nCo-authored-by: Jane Doe <jane@example.com>", "Jane Doe", "jane@example.com"),
+	}
+
+	got := engine.ContributorsOf(commits, engine.ContributorOptions{
+		Mailmap:        mailmap,
+		History:        history,
+		SkipCommitters: true,
+	})
+	want := engine.Contributors{
+		{Name: "Jane Doe", Email: "jane@example.com", Commits: 3, FirstTime: true},
+		{Name: "Ann", Email: "ann@example.com", Commits: 2, FirstTime: true},
+		{Name: "Bob", Email: "BOB@example.com", Commits: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ContributorsOf() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ContributorsOf()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	first := got.FirstTime()
+	if len(first) != 2 || first[0].Name != "Jane Doe" || first[1].Name != "Ann" {
+		t.Errorf("FirstTime() = %+v", first)
+	}
+
+	data, err := json.Marshal(got[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"name":"Bob","email":"BOB@example.com","commits":1,"firstTime":false}` {
+		t.Errorf("json.Marshal() = %s", data)
+	}
+}
+
+func TestContributorsOf_TrailerBlock(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    int
+	}{
+		{"folded_conventional", "feat: x\n\nCo-authored-by: Bob Builder\n <bob@x.org>", 2},
+		{"folded_plain", "Update docs\n\nCo-authored-by: Bob Builder\n <bob@x.org>", 2},
+		{"prose_paragraph", "Update docs\n\nThanks to Bob for the review.\nCo-authored-by: Bob Builder <bob@x.org>", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := engine.ContributorsOf([]git.Commit{authored("aaaa", tt.message, "Ann", "ann@example.com")},
+				engine.ContributorOptions{SkipCommitters: true})
+			if len(got) != tt.want {
+				t.Errorf("ContributorsOf() = %+v, want %d contributors", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContributorsOf_Committers(t *testing.T) {
+	commits := []git.Commit{
+		authored("aaaa", "feat: a", "Jane", "jane@example.com"),
+		authored("bbbb", "fix: b", "Jane", "jane@example.com"),
+	}
+	got := engine.ContributorsOf(commits, engine.ContributorOptions{})
+	if len(got) != 2 || got[0].Name != "GitHub" || got[0].Commits != 2 || got[1].Name != "Jane" {
+		t.Errorf("ContributorsOf() = %+v, want Jane and the committer", got)
+	}
+
+	// A person who both authored and committed a commit is counted once.
+	self := commit("cccc", "fix: c")
+	self.Author = git.Signature{Name: "Jane", Email: "jane@example.com"}
+	self.Committer = self.Author
+	got = engine.ContributorsOf([]git.Commit{self}, engine.ContributorOptions{})
+	if len(got) != 1 || got[0].Commits != 1 {
+		t.Errorf("ContributorsOf() = %+v, want one commit for Jane", got)
+	}
+}
@@ -0,0 +1,237 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package engine implements the dxrel version engine: it turns the commits
+// of a CommitRange into classified entries, removes changes that cancel each
+// other out, and derives the Bump and next Version for a module.
+//
+// The engine is deliberately free of I/O. Callers resolve a CommitRange into
+// a slice of git.Commit values (oldest first) using whatever commit reader
+// they have, and hand that slice to Classify or ClassifyWith. Every later
+// stage operates on the resulting []Entry, which is also the input for
+// changelog rendering, so anything the engine drops from the slice disappears
+// from both the version computation and the changelog.
+package engine
+
+import (
+	"strings"
+
+	"dirpx.dev/dxrel/dxcore/dialect"
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/model/semver"
+)
+
+// Entry is a single commit together with its Conventional Commit
+// interpretation and the Bump it contributes.
+//
+// Conventional is false when the commit message could not be parsed as a
+// Conventional Commit; such entries carry a zero Message and BumpNone but
+// are kept so that revert detection and reporting still see them.
+type Entry struct {
+	// Commit is the underlying git commit.
+	Commit git.Commit
+
+	// Message is the parsed Conventional Commit message. It is the zero
+	// value when Conventional is false.
+	Message conventional.Message
+
+	// Conventional reports whether Commit.Message parsed successfully.
+	Conventional bool
+
+	// Virtual reports whether Message was derived from part of the commit
+	// message (for example, one bullet of a squash-merge body) rather than
+	// from the commit header. A single commit MAY yield several virtual
+	// entries.
+	Virtual bool
+
+	// Bump is the version increment this entry requests on its own.
+	Bump change.Bump
+}
+
+// NewEntry classifies a single commit. Parse failures are not errors: the
+// entry is returned with Conventional set to false and BumpNone.
+func NewEntry(c git.Commit) Entry {
+	return NewEntryWith(c, dialect.Conventional)
+}
+
+// NewEntryWith is like NewEntry but parses the commit message with d. When d
+// implements dialect.Bumper, the dialect decides the Bump; otherwise BumpFor
+// is applied to the parsed message.
+func NewEntryWith(c git.Commit, d dialect.Dialect) Entry {
+	e := Entry{Commit: c}
+	msg, err := d.Parse(c.Message)
+	if err != nil {
+		return e
+	}
+	e.Message = msg
+	e.Conventional = true
+	e.Bump = bumpWith(d, c.Message, msg)
+	return e
+}
+
+// Reverts returns the (possibly abbreviated) hashes of the commits this
+// entry reverts, as found by conventional.ParseReverts in the raw commit
+// message. Non-conventional messages such as the default git revert header
+// are scanned as well.
+func (e Entry) Reverts() []string {
+	return conventional.ParseReverts(e.Commit.Message)
+}
+
+// BumpFor returns the Bump requested by a single Conventional Commit
+// message:
+//
+//   - any breaking change (header "!" or BREAKING CHANGE footer) -> BumpMajor
+//   - feat                                                       -> BumpMinor
+//   - fix, perf, revert                                          -> BumpPatch
+//   - everything else                                            -> BumpNone
+//
+// A revert only reaches this mapping when its target lies outside the
+// analyzed range (see CancelReverts), in which case it undoes released
+// behavior and is treated like a fix.
+func BumpFor(m conventional.Message) change.Bump {
+	if m.Breaking {
+		return change.BumpMajor
+	}
+	switch m.Type {
+	case conventional.Feat:
+		return change.BumpMinor
+	case conventional.Fix, conventional.Perf, conventional.Revert:
+		return change.BumpPatch
+	default:
+		return change.BumpNone
+	}
+}
+
+// bumpWith returns the Bump of msg, parsed from raw with d.
+func bumpWith(d dialect.Dialect, raw string, msg conventional.Message) change.Bump {
+	if b, ok := d.(dialect.Bumper); ok {
+		if bump, ok := b.Bump(raw); ok {
+			return bump
+		}
+	}
+	return BumpFor(msg)
+}
+
+// Classify converts the commits of a range into entries, preserving order.
+// The commits SHOULD be ordered oldest first.
+func Classify(commits []git.Commit) []Entry {
+	return classify(commits, dialect.Conventional)
+}
+
+func classify(commits []git.Commit, d dialect.Dialect) []Entry {
+	entries := make([]Entry, 0, len(commits))
+	for _, c := range commits {
+		entries = append(entries, NewEntryWith(c, d))
+	}
+	return entries
+}
+
+// CancelReverts removes every revert whose target lies within entries,
+// together with the reverted commit, and returns the remaining entries in
+// their original order. The input slice is not modified.
+//
+// Cancellation works on commits rather than entries: when a commit was
+// expanded into several virtual entries (see ClassifyWith), all of them are
+// dropped together.
+//
+// Entries are processed newest first so that chains resolve naturally: if C
+// reverts B and B reverts A, then C and B cancel each other and A stays.
+// Reverts whose target is not part of entries (for example, reverting a
+// commit that was already released) are kept and contribute their own Bump.
+// Target hashes MAY be abbreviated and are matched by prefix.
+func CancelReverts(entries []Entry) []Entry {
+	dropped := make(map[git.Hash]bool)
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		hash := entries[i].Commit.Hash
+		if dropped[hash] {
+			continue
+		}
+		for _, target := range entries[i].Reverts() {
+			reverted, ok := findCommit(entries[:i], dropped, hash, target)
+			if !ok {
+				continue
+			}
+			dropped[hash] = true
+			dropped[reverted] = true
+		}
+	}
+
+	result := make([]Entry, 0, len(entries))
+	for _, e := range entries {
+		if !dropped[e.Commit.Hash] {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// findCommit returns the hash of the newest non-dropped commit in entries,
+// other than self, whose hash starts with prefix.
+func findCommit(entries []Entry, dropped map[git.Hash]bool, self git.Hash, prefix string) (git.Hash, bool) {
+	for j := len(entries) - 1; j >= 0; j-- {
+		h := entries[j].Commit.Hash
+		if h != self && !dropped[h] && strings.HasPrefix(string(h), prefix) {
+			return h, true
+		}
+	}
+	return "", false
+}
+
+// MaxBump returns the highest Bump requested by any entry, or BumpNone for
+// an empty slice.
+func MaxBump(entries []Entry) change.Bump {
+	result := change.BumpNone
+	for _, e := range entries {
+		if e.Bump > result {
+			result = e.Bump
+		}
+	}
+	return result
+}
+
+// Apply increments v according to b. Prerelease and build metadata are
+// always cleared; BumpNone returns v unchanged.
+func Apply(v semver.Version, b change.Bump) semver.Version {
+	switch b {
+	case change.BumpMajor:
+		return semver.Version{Major: v.Major + 1}
+	case change.BumpMinor:
+		return semver.Version{Major: v.Major, Minor: v.Minor + 1}
+	case change.BumpPatch:
+		return semver.Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}
+	default:
+		return v
+	}
+}
+
+// Next computes the version that follows last for the given entries under
+// strategy. Entries MUST be ordered oldest first for Sequential to be
+// meaningful. Callers that want reverted changes ignored SHOULD pass the
+// result of CancelReverts.
+func Next(last semver.Version, entries []Entry, strategy model.Strategy) semver.Version {
+	if strategy == model.Sequential {
+		v := last
+		for _, e := range entries {
+			v = Apply(v, e.Bump)
+		}
+		return v
+	}
+	return Apply(last, MaxBump(entries))
+}
@@ -0,0 +1,191 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package engine_test
+
+import (
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/engine"
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/model/semver"
+)
+
+// hashOf builds a deterministic 40-character hash from a short seed.
+func hashOf(seed string) git.Hash {
+	return git.Hash(seed + strings.Repeat("0", 40-len(seed)))
+}
+
+func commit(seed, message string) git.Commit {
+	return git.Commit{Hash: hashOf(seed), Message: message}
+}
+
+func hashes(entries []engine.Entry) []string {
+	out := make([]string, len(entries))
+	for i, e := range entries {
+		out[i] = string(e.Commit.Hash)[:4]
+	}
+	return out
+}
+
+func TestBumpFor(t *testing.T) {
+	tests := []struct {
+		message string
+		want    change.Bump
+	}{
+		{"feat: add x", change.BumpMinor},
+		{"fix: repair x", change.BumpPatch},
+		{"perf: speed up x", change.BumpPatch},
+		{"revert: undo x", change.BumpPatch},
+		{"docs: explain x", change.BumpNone},
+		{"chore!: drop go1.20", change.BumpMajor},
+		{"feat: x\n\nBREAKING CHANGE: y", change.BumpMajor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			e := engine.NewEntry(commit("aaaa", tt.message))
+			if !e.Conventional {
+				t.Fatalf("NewEntry() Conventional = false for %q", tt.message)
+			}
+			if e.Bump != tt.want {
+				t.Errorf("Bump = %v, want %v", e.Bump, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewEntry_NonConventional(t *testing.T) {
+	e := engine.NewEntry(commit("aaaa", "Merge branch 'main'"))
+	if e.Conventional {
+		t.Error("Conventional = true, want false")
+	}
+	if e.Bump != change.BumpNone {
+		t.Errorf("Bump = %v, want none", e.Bump)
+	}
+}
+
+func TestCancelReverts(t *testing.T) {
+	tests := []struct {
+		name    string
+		commits []git.Commit
+		want    []string
+	}{
+		{
+			name: "revert_cancels_breaking_feature",
+			commits: []git.Commit{
+				commit("aaaa", "feat!: remove endpoint"),
+				commit("bbbb", "fix: small fix"),
+				commit("cccc", "Revert \"feat!: remove endpoint\"\n\nThis reverts commit "+string(hashOf("aaaa"))+"."),
+			},
+			want: []string{"bbbb"},
+		},
+		{
+			name: "abbreviated_trailer",
+			commits: []git.Commit{
+				commit("aaaa", "feat: add x"),
+				commit("cccc", "revert: drop x\n\nReverts: aaaa000"),
+			},
+			want: []string{},
+		},
+		{
+			name: "target_outside_range_kept",
+			commits: []git.Commit{
+				commit("bbbb", "fix: small fix"),
+				commit("cccc", "revert: drop x\n\nThis reverts commit dddd000000000000000000000000000000000000."),
+			},
+			want: []string{"bbbb", "cccc"},
+		},
+		{
+			name: "revert_of_revert_restores_original",
+			commits: []git.Commit{
+				commit("aaaa", "feat: add x"),
+				commit("bbbb", "revert: drop x\n\nThis reverts commit "+string(hashOf("aaaa"))+"."),
+				commit("cccc", "revert: restore x\n\nThis reverts commit "+string(hashOf("bbbb"))+"."),
+			},
+			want: []string{"aaaa"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hashes(engine.CancelReverts(engine.Classify(tt.commits)))
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("CancelReverts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	last := semver.Version{Major: 1}
+	entries := engine.Classify([]git.Commit{
+		commit("aaaa", "feat: a"),
+		commit("bbbb", "fix: b"),
+		commit("cccc", "feat!: c"),
+		commit("dddd", "fix: d"),
+	})
+
+	tests := []struct {
+		strategy model.Strategy
+		want     string
+	}{
+		{model.MaxSeverity, "2.0.0"},
+		{model.Sequential, "2.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.strategy.String(), func(t *testing.T) {
+			if got := engine.Next(last, entries, tt.strategy).String(); got != tt.want {
+				t.Errorf("Next() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNext_RevertedBreakingChange(t *testing.T) {
+	entries := engine.Classify([]git.Commit{
+		commit("aaaa", "feat!: remove endpoint"),
+		commit("bbbb", "fix: small fix"),
+		commit("cccc", "Revert \"feat!: remove endpoint\"\n\nThis reverts commit "+string(hashOf("aaaa"))+"."),
+	})
+
+	got := engine.Next(semver.Version{Major: 1, Minor: 2}, engine.CancelReverts(entries), model.MaxSeverity)
+	if got.String() != "1.2.1" {
+		t.Errorf("Next() = %s, want 1.2.1", got)
+	}
+}
+
+func TestApply(t *testing.T) {
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"}
+	tests := []struct {
+		bump change.Bump
+		want string
+	}{
+		{change.BumpNone, "1.2.3-rc.1"},
+		{change.BumpPatch, "1.2.4"},
+		{change.BumpMinor, "1.3.0"},
+		{change.BumpMajor, "2.0.0"},
+	}
+	for _, tt := range tests {
+		if got := engine.Apply(v, tt.bump).String(); got != tt.want {
+			t.Errorf("Apply(%v) = %s, want %s", tt.bump, got, tt.want)
+		}
+	}
+}
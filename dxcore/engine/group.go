@@ -0,0 +1,76 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package engine
+
+import (
+	"sort"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+// ScopeGroup is the set of entries that share a scope, as rendered under one
+// heading of a changelog.
+type ScopeGroup struct {
+	// Scope is the shared scope. It is empty for the group of unscoped
+	// entries.
+	Scope conventional.Scope
+
+	// Entries are the entries of the group, in their original order.
+	Entries []Entry
+}
+
+// GroupByScope groups conventional entries by scope for changelog rendering.
+// An entry with several scopes ("feat(api,cli): ...") is listed in the group
+// of each of them. Groups are ordered by scope name, with the unscoped group
+// last; non-conventional entries are omitted.
+func GroupByScope(entries []Entry) []ScopeGroup {
+	index := make(map[conventional.Scope]int)
+	var groups []ScopeGroup
+
+	add := func(s conventional.Scope, e Entry) {
+		i, ok := index[s]
+		if !ok {
+			i = len(groups)
+			index[s] = i
+			groups = append(groups, ScopeGroup{Scope: s})
+		}
+		groups[i].Entries = append(groups[i].Entries, e)
+	}
+
+	for _, e := range entries {
+		if !e.Conventional {
+			continue
+		}
+		list := e.Message.ScopeList()
+		if len(list) == 0 {
+			add("", e)
+			continue
+		}
+		for _, s := range list {
+			add(s, e)
+		}
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		a, b := groups[i].Scope, groups[j].Scope
+		if a == "" || b == "" {
+			return b == "" && a != ""
+		}
+		return a < b
+	})
+	return groups
+}
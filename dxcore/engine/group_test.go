@@ -0,0 +1,46 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package engine_test
+
+import (
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/engine"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+func TestGroupByScope(t *testing.T) {
+	entries := engine.Classify([]git.Commit{
+		commit("aaaa", "feat(cli): add flag"),
+		commit("bbbb", "fix: general fix"),
+		commit("cccc", "feat(api, cli): shared change"),
+		commit("dddd", "not conventional"),
+		commit("eeee", "fix(api): repair"),
+	})
+
+	groups := engine.GroupByScope(entries)
+
+	var got []string
+	for _, g := range groups {
+		got = append(got, string(g.Scope)+"="+strings.Join(hashes(g.Entries), ","))
+	}
+	want := []string{"api=cccc,eeee", "cli=aaaa,cccc", "=bbbb"}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("GroupByScope() = %v, want %v", got, want)
+	}
+}
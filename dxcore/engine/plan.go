@@ -0,0 +1,235 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"dirpx.dev/dxrel/dxcore/dialect"
+	"dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/model/semver"
+)
+
+// Action identifies what the engine did with a commit while planning a
+// release. Actions are recorded in the Plan trace.
+type Action string
+
+const (
+	// ActionBump records that an entry contributed its Bump.
+	ActionBump Action = "bump"
+
+	// ActionCancel records that an entry was removed because it was reverted
+	// within the range, or because it reverted another entry in the range.
+	ActionCancel Action = "cancel"
+
+	// ActionSkip records that an entry was excluded by a "Skip-Release: true"
+	// or "dxrel: skip" trailer.
+	ActionSkip Action = "skip"
+
+	// ActionReleaseAs records that a "Release-As" trailer forced the next
+	// version.
+	ActionReleaseAs Action = "release-as"
+)
+
+// Step is one line of the plan trace: the decision the engine took for a
+// single commit and why.
+type Step struct {
+	// Commit is the hash of the commit the decision applies to.
+	Commit git.Hash `json:"commit" yaml:"commit"`
+
+	// Action is the decision taken.
+	Action Action `json:"action" yaml:"action"`
+
+	// Bump is the Bump requested by the commit. It is BumpNone for
+	// non-conventional commits.
+	Bump change.Bump `json:"bump" yaml:"bump"`
+
+	// Reason is a short human-readable explanation of the decision.
+	Reason string `json:"reason" yaml:"reason"`
+}
+
+// Options configures PlanRelease.
+type Options struct {
+	// Strategy selects how the Bumps of several entries are combined.
+	Strategy model.Strategy
+
+	// Mode selects which commits are classified and from which text.
+	Mode model.ClassificationMode
+
+	// Dialect parses commit messages. A nil Dialect selects
+	// dialect.Conventional; repositories choose another one by name through
+	// dialect.Lookup.
+	Dialect dialect.Dialect
+}
+
+// Plan is the outcome of planning a release for one module: the version it
+// moves from and to, the entries that justify the change, and a trace that
+// lets reviewers see why the version moved the way it did.
+type Plan struct {
+	// Last is the last released version of the module.
+	Last semver.Version `json:"last" yaml:"last"`
+
+	// Next is the planned version. It equals Last when nothing warrants a
+	// release.
+	Next semver.Version `json:"next" yaml:"next"`
+
+	// Bump is the highest Bump requested by Entries.
+	Bump change.Bump `json:"bump" yaml:"bump"`
+
+	// ReleaseAs is the hash of the commit whose Release-As trailer forced
+	// Next. It is empty when Next was computed from Bump.
+	ReleaseAs git.Hash `json:"releaseAs,omitempty" yaml:"releaseAs,omitempty"`
+
+	// Entries are the entries that remain after reverts and skips were
+	// removed, oldest first.
+	Entries []Entry `json:"-" yaml:"-"`
+
+	// Trace records one Step per commit decision, oldest first.
+	Trace []Step `json:"trace" yaml:"trace"`
+}
+
+// PlanRelease computes the release plan for a module whose last released
+// version is last, given the commits of the range since that release (oldest
+// first).
+//
+// Commits are classified according to opts.Mode, reverted changes are
+// cancelled (see CancelReverts), and commits carrying a skip trailer are
+// excluded. The remaining entries determine the next version under
+// opts.Strategy, unless one of their commits carries a "Release-As" trailer,
+// in which case the newest such trailer forces the next version.
+//
+// The skip and Release-As trailers are read once per commit from the
+// trailer block of its raw message (see conventional.ParseTrailers), never
+// from the message of an entry: they apply to every entry of a squashed
+// commit, and to commits that are not Conventional Commits.
+//
+// A forced version MUST be a valid semantic version strictly greater than
+// last; otherwise PlanRelease returns a *ValidationError and a zero Plan.
+func PlanRelease(last semver.Version, commits []git.Commit, opts Options) (Plan, error) {
+	plan := Plan{Last: last}
+
+	all := ClassifyDialect(commits, opts.Mode, opts.Dialect)
+	kept := CancelReverts(all)
+
+	survived := make(map[git.Hash]bool, len(kept))
+	for _, e := range kept {
+		survived[e.Commit.Hash] = true
+	}
+
+	controls := make(map[git.Hash]conventional.Message, len(commits))
+	var releaseAs *Entry
+	for i, e := range all {
+		ctl, ok := controls[e.Commit.Hash]
+		if !ok {
+			ctl = controlTrailers(e.Commit)
+			controls[e.Commit.Hash] = ctl
+		}
+
+		step := Step{Commit: e.Commit.Hash, Bump: e.Bump}
+		switch {
+		case !survived[e.Commit.Hash]:
+			step.Action = ActionCancel
+			step.Reason = "cancelled by a revert within the range"
+		case ctl.SkipRelease():
+			step.Action = ActionSkip
+			step.Reason = "excluded by skip trailer"
+		default:
+			step.Action = ActionBump
+			step.Reason = bumpReason(e)
+			plan.Entries = append(plan.Entries, e)
+			if _, ok := ctl.ReleaseAs(); ok && (releaseAs == nil || releaseAs.Commit.Hash != e.Commit.Hash) {
+				releaseAs = &all[i]
+			}
+		}
+		plan.Trace = append(plan.Trace, step)
+	}
+
+	plan.Bump = MaxBump(plan.Entries)
+	plan.Next = Next(last, plan.Entries, opts.Strategy)
+
+	if releaseAs == nil {
+		return plan, nil
+	}
+
+	forced, err := releaseAsVersion(last, *releaseAs, controls[releaseAs.Commit.Hash])
+	if err != nil {
+		return Plan{}, err
+	}
+	plan.Trace = append(plan.Trace, Step{
+		Commit: releaseAs.Commit.Hash,
+		Action: ActionReleaseAs,
+		Bump:   releaseAs.Bump,
+		Reason: fmt.Sprintf("Release-As forces %s (computed %s)", forced, plan.Next),
+	})
+	plan.Next = forced
+	plan.ReleaseAs = releaseAs.Commit.Hash
+	return plan, nil
+}
+
+// controlTrailers returns a message holding only the trailers of the raw
+// message of c, whose SkipRelease and ReleaseAs methods read the release
+// control trailers of the commit. A message without a trailer block yields
+// no trailers.
+func controlTrailers(c git.Commit) conventional.Message {
+	trailers, err := conventional.ParseTrailers(c.Message, conventional.DefaultTrailerConfig)
+	if err != nil {
+		return conventional.Message{}
+	}
+	return conventional.Message{Trailers: trailers}
+}
+
+// releaseAsVersion parses and validates the Release-As trailer of ctl, the
+// control trailers of the commit of e, against the last released version.
+func releaseAsVersion(last semver.Version, e Entry, ctl conventional.Message) (semver.Version, error) {
+	raw, _ := ctl.ReleaseAs()
+	v, err := semver.ParseVersion(raw)
+	if err != nil {
+		return semver.Version{}, &errors.ValidationError{
+			Code:   errors.CodeFormat,
+			Type:   "Plan",
+			Field:  "ReleaseAs",
+			Reason: fmt.Sprintf("commit %s: invalid Release-As version: %v", e.Commit.Hash.Short(), err),
+			Value:  raw,
+			Err:    err,
+		}
+	}
+	if !v.Greater(last) {
+		return semver.Version{}, &errors.ValidationError{
+			Code:   errors.CodeOutOfRange,
+			Type:   "Plan",
+			Field:  "ReleaseAs",
+			Reason: fmt.Sprintf("commit %s: Release-As %s must be greater than last released version %s", e.Commit.Hash.Short(), v, last),
+			Value:  raw,
+		}
+	}
+	return v, nil
+}
+
+// bumpReason explains the Bump of an entry that takes part in the release.
+func bumpReason(e Entry) string {
+	if !e.Conventional {
+		return "not a conventional commit"
+	}
+	if e.Message.Breaking {
+		return "breaking change"
+	}
+	return e.Message.Type.String()
+}
@@ -0,0 +1,213 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package engine_test
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/engine"
+	"dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/model/semver"
+)
+
+func TestPlanRelease(t *testing.T) {
+	last := semver.Version{Major: 1, Minor: 4}
+
+	tests := []struct {
+		name      string
+		commits   []git.Commit
+		want      string
+		releaseAs git.Hash
+		actions   []engine.Action
+	}{
+		{
+			name: "computed",
+			commits: []git.Commit{
+				commit("aaaa", "feat: add x"),
+				commit("bbbb", "fix: repair y"),
+			},
+			want:    "1.5.0",
+			actions: []engine.Action{engine.ActionBump, engine.ActionBump},
+		},
+		{
+			name: "skip_release_trailer",
+			commits: []git.Commit{
+				commit("aaaa", "feat: add x\n\nSkip-Release: true"),
+				commit("bbbb", "fix: repair y"),
+			},
+			want:    "1.4.1",
+			actions: []engine.Action{engine.ActionSkip, engine.ActionBump},
+		},
+		{
+			name: "dxrel_skip_trailer",
+			commits: []git.Commit{
+				commit("aaaa", "feat!: drop x\n\ndxrel: skip"),
+			},
+			want:    "1.4.0",
+			actions: []engine.Action{engine.ActionSkip},
+		},
+		{
+			name: "release_as_forces_version",
+			commits: []git.Commit{
+				commit("aaaa", "fix: repair y"),
+				commit("bbbb", "chore: prepare 2.0\n\nRelease-As: 2.0.0"),
+			},
+			want:      "2.0.0",
+			releaseAs: hashOf("bbbb"),
+			actions:   []engine.Action{engine.ActionBump, engine.ActionBump, engine.ActionReleaseAs},
+		},
+		{
+			name: "release_as_on_skipped_commit_ignored",
+			commits: []git.Commit{
+				commit("aaaa", "fix: repair y"),
+				commit("bbbb", "chore: prepare 2.0\n\nRelease-As: 2.0.0\nSkip-Release: true"),
+			},
+			want:    "1.4.1",
+			actions: []engine.Action{engine.ActionBump, engine.ActionSkip},
+		},
+		{
+			name: "reverted_release_as_ignored",
+			commits: []git.Commit{
+				commit("aaaa", "chore: prepare 2.0\n\nRelease-As: 2.0.0"),
+				commit("bbbb", "revert: undo 2.0\n\nReverts: aaaa000"),
+			},
+			want:    "1.4.0",
+			actions: []engine.Action{engine.ActionCancel, engine.ActionCancel},
+		},
+		{
+			name: "release_as_on_non_conventional_commit",
+			commits: []git.Commit{
+				commit("aaaa", "fix: repair y"),
+				commit("bbbb", "Prepare 2.0\n\nRelease-As: 2.0.0"),
+			},
+			want:      "2.0.0",
+			releaseAs: hashOf("bbbb"),
+			actions:   []engine.Action{engine.ActionBump, engine.ActionBump, engine.ActionReleaseAs},
+		},
+		{
+			name: "skip_on_non_conventional_commit",
+			commits: []git.Commit{
+				commit("aaaa", "Update vendored deps\n\nSkip-Release: true"),
+			},
+			want:    "1.4.0",
+			actions: []engine.Action{engine.ActionSkip},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := engine.PlanRelease(last, tt.commits, engine.Options{Strategy: model.MaxSeverity})
+			if err != nil {
+				t.Fatalf("PlanRelease() error = %v", err)
+			}
+			if got := plan.Next.String(); got != tt.want {
+				t.Errorf("Next = %s, want %s", got, tt.want)
+			}
+			if plan.ReleaseAs != tt.releaseAs {
+				t.Errorf("ReleaseAs = %q, want %q", plan.ReleaseAs, tt.releaseAs)
+			}
+			if len(plan.Trace) != len(tt.actions) {
+				t.Fatalf("Trace = %+v, want %d steps", plan.Trace, len(tt.actions))
+			}
+			for i, step := range plan.Trace {
+				if step.Action != tt.actions[i] {
+					t.Errorf("Trace[%d].Action = %s, want %s", i, step.Action, tt.actions[i])
+				}
+				if step.Reason == "" {
+					t.Errorf("Trace[%d].Reason is empty", i)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanRelease_SquashControlTrailers(t *testing.T) {
+	last := semver.Version{Major: 1, Minor: 4}
+	opts := engine.Options{Strategy: model.MaxSeverity, Mode: model.ClassifySquash}
+
+	plan, err := engine.PlanRelease(last, []git.Commit{
+		commit("aaaa", "Big change (#12)\n\n* feat: add x\n* fix: repair y\n\nRelease-As: 2.0.0"),
+	}, opts)
+	if err != nil {
+		t.Fatalf("PlanRelease() error = %v", err)
+	}
+	if plan.Next.String() != "2.0.0" || plan.ReleaseAs != hashOf("aaaa") {
+		t.Errorf("Next = %s, ReleaseAs = %q", plan.Next, plan.ReleaseAs)
+	}
+	want := []engine.Action{engine.ActionBump, engine.ActionBump, engine.ActionReleaseAs}
+	if len(plan.Trace) != len(want) {
+		t.Fatalf("Trace = %+v, want %d steps", plan.Trace, len(want))
+	}
+
+	plan, err = engine.PlanRelease(last, []git.Commit{
+		commit("aaaa", "Big change (#12)\n\n* feat: add x\n* fix: repair y\n\nSkip-Release: true"),
+		commit("bbbb", "fix: repair z"),
+	}, opts)
+	if err != nil {
+		t.Fatalf("PlanRelease() error = %v", err)
+	}
+	if plan.Next.String() != "1.4.1" {
+		t.Errorf("Next = %s, want 1.4.1", plan.Next)
+	}
+	for i, a := range []engine.Action{engine.ActionSkip, engine.ActionSkip, engine.ActionBump} {
+		if plan.Trace[i].Action != a {
+			t.Errorf("Trace[%d].Action = %s, want %s", i, plan.Trace[i].Action, a)
+		}
+	}
+
+	// A control trailer inside a bullet is not a trailer of the commit.
+	plan, err = engine.PlanRelease(last, []git.Commit{
+		commit("aaaa", "Big change (#12)\n\n* feat: add x\n\n  Skip-Release: true\n* fix: repair y"),
+	}, opts)
+	if err != nil {
+		t.Fatalf("PlanRelease() error = %v", err)
+	}
+	if plan.Next.String() != "1.5.0" {
+		t.Errorf("Next = %s, want 1.5.0", plan.Next)
+	}
+}
+
+func TestPlanRelease_InvalidReleaseAs(t *testing.T) {
+	last := semver.Version{Major: 2, Minor: 1}
+
+	tests := []struct {
+		name  string
+		value string
+		code  errors.Code
+	}{
+		{"not_semver", "next", errors.CodeFormat},
+		{"backwards", "2.0.0", errors.CodeOutOfRange},
+		{"same_as_last", "2.1.0", errors.CodeOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commits := []git.Commit{commit("aaaa", "chore: pin\n\nRelease-As: "+tt.value)}
+			_, err := engine.PlanRelease(last, commits, engine.Options{})
+			var verr *errors.ValidationError
+			if !stderrors.As(err, &verr) {
+				t.Fatalf("PlanRelease() error = %v, want *ValidationError", err)
+			}
+			if verr.Path() != "Plan.ReleaseAs" || verr.Code != tt.code {
+				t.Errorf("error = %s %q, want %s Plan.ReleaseAs", verr.Code, verr.Path(), tt.code)
+			}
+		})
+	}
+}
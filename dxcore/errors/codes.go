@@ -0,0 +1,127 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package errors
+
+import (
+	stderrors "errors"
+	"strings"
+)
+
+// Code is a stable, machine-readable classification of a validation
+// failure. Codes are part of the API: the CLI and API layers use them to
+// translate and filter errors, so existing values MUST NOT change.
+//
+// Code implements error so that it can be used as an errors.Is target:
+//
+//	if errors.Is(err, errors.CodeRequired) { ... }
+type Code string
+
+const (
+	// CodeRequired reports a required value that is missing or empty.
+	CodeRequired Code = "required"
+
+	// CodeTooShort reports a value shorter than its minimum length.
+	CodeTooShort Code = "too_short"
+
+	// CodeTooLong reports a value longer than its maximum length or size.
+	CodeTooLong Code = "too_long"
+
+	// CodeTooMany reports a collection with more elements than allowed.
+	CodeTooMany Code = "too_many"
+
+	// CodeFormat reports a value that does not have the required syntax,
+	// for example a scope with uppercase letters or a subject spanning
+	// several lines.
+	CodeFormat Code = "invalid_format"
+
+	// CodeOutOfRange reports an enum-like value that is not one of the
+	// defined constants, or a number outside its allowed range.
+	CodeOutOfRange Code = "out_of_range"
+
+	// CodeDuplicate reports a value that occurs more than once where it
+	// MUST be unique.
+	CodeDuplicate Code = "duplicate"
+
+	// CodeInconsistent reports fields that are individually valid but
+	// contradict each other.
+	CodeInconsistent Code = "inconsistent"
+
+	// CodeInvalid reports any other failure, including errors of nested
+	// values that are not *ValidationError.
+	CodeInvalid Code = "invalid"
+)
+
+// Error returns "dxapi: " followed by the code.
+func (c Code) Error() string {
+	return "dxapi: " + string(c)
+}
+
+// Nest re-roots err, the validation error of a value stored in field of a
+// value of type typ, so that its path starts at typ. When err is (or wraps)
+// a *ValidationError, its Code, Reason, Value and Err are kept and its Field
+// is appended to field:
+//
+//	Nest(&ValidationError{Type: "FileChange", Field: "Path", ...}, "Commit", "Changes[3]")
+//	// Type: "Commit", Field: "Changes[3].Path"
+//
+// Any other error becomes a CodeInvalid *ValidationError wrapping it. The
+// errors joined in err, as returned by FullValidator.ValidateFull, are
+// re-rooted one by one and joined again. Nest returns nil if err is nil.
+func Nest(err error, typ, field string) error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var errs []error
+		for _, e := range joined.Unwrap() {
+			errs = append(errs, Nest(e, typ, field))
+		}
+		return stderrors.Join(errs...)
+	}
+	var ve *ValidationError
+	if stderrors.As(err, &ve) {
+		nested := *ve
+		nested.Type = typ
+		nested.Field = JoinPath(field, ve.Field)
+		if nested.Code == "" {
+			nested.Code = CodeInvalid
+		}
+		return &nested
+	}
+	return &ValidationError{
+		Code:   CodeInvalid,
+		Type:   typ,
+		Field:  field,
+		Reason: strings.TrimPrefix(err.Error(), "dxapi: "),
+		Err:    err,
+	}
+}
+
+// JoinPath joins two field paths with ".", omitting the separator before an
+// index ("Changes" and "[3]" join as "Changes[3]") and ignoring empty parts.
+func JoinPath(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	default:
+		return parent + "." + child
+	}
+}
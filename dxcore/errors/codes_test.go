@@ -0,0 +1,135 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+)
+
+func TestJoinPath(t *testing.T) {
+	tests := []struct {
+		parent, child, want string
+	}{
+		{"", "", ""},
+		{"Commit", "", "Commit"},
+		{"", "Path", "Path"},
+		{"Changes", "[3]", "Changes[3]"},
+		{"Changes[3]", "Path", "Changes[3].Path"},
+		{"Trailers[0]", "Key", "Trailers[0].Key"},
+	}
+	for _, tt := range tests {
+		if got := JoinPath(tt.parent, tt.child); got != tt.want {
+			t.Errorf("JoinPath(%q, %q) = %q, want %q", tt.parent, tt.child, got, tt.want)
+		}
+	}
+}
+
+func TestValidationError_Path(t *testing.T) {
+	tests := []struct {
+		err  *ValidationError
+		want string
+	}{
+		{&ValidationError{Type: "Commit"}, "Commit"},
+		{&ValidationError{Type: "Commit", Field: "Changes[3].Path"}, "Commit.Changes[3].Path"},
+		{&ValidationError{Field: "Path"}, "Path"},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Path(); got != tt.want {
+			t.Errorf("Path() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestNest(t *testing.T) {
+	if Nest(nil, "Commit", "Hash") != nil {
+		t.Fatal("Nest(nil) must return nil")
+	}
+
+	inner := &ValidationError{
+		Code:   CodeFormat,
+		Type:   "FileChange",
+		Field:  "Path",
+		Reason: "path is absolute",
+		Value:  "/etc/passwd",
+	}
+	err := Nest(Nest(inner, "FileChange", ""), "Commit", "Changes[3]")
+
+	var ve *ValidationError
+	if !stderrors.As(err, &ve) {
+		t.Fatalf("Nest() = %T, want *ValidationError", err)
+	}
+	if ve.Path() != "Commit.Changes[3].Path" {
+		t.Errorf("Path() = %q, want %q", ve.Path(), "Commit.Changes[3].Path")
+	}
+	if ve.Code != CodeFormat || ve.Reason != inner.Reason || ve.Value != inner.Value {
+		t.Errorf("Nest() lost details: %+v", ve)
+	}
+	if inner.Field != "Path" || inner.Type != "FileChange" {
+		t.Errorf("Nest() modified the inner error: %+v", inner)
+	}
+
+	plain := fmt.Errorf("boom")
+	err = Nest(plain, "Ref", "Name")
+	if !stderrors.As(err, &ve) {
+		t.Fatalf("Nest() = %T, want *ValidationError", err)
+	}
+	if ve.Code != CodeInvalid || ve.Path() != "Ref.Name" {
+		t.Errorf("Nest(plain) = %+v", ve)
+	}
+	if !stderrors.Is(err, plain) {
+		t.Error("Nest(plain) must wrap the original error")
+	}
+}
+
+func TestValidationError_Is(t *testing.T) {
+	cause := fmt.Errorf("cause")
+	err := fmt.Errorf("context: %w", &ValidationError{
+		Code:  CodeTooLong,
+		Type:  "Commit",
+		Field: "Message",
+		Err:   cause,
+	})
+
+	tests := []struct {
+		name   string
+		target error
+		want   bool
+	}{
+		{"same code", CodeTooLong, true},
+		{"other code", CodeRequired, false},
+		{"pattern by type", &ValidationError{Type: "Commit"}, true},
+		{"pattern by code and field", &ValidationError{Code: CodeTooLong, Field: "Message"}, true},
+		{"pattern with other field", &ValidationError{Type: "Commit", Field: "Hash"}, false},
+		{"pattern with other type", &ValidationError{Type: "Tag"}, false},
+		{"wrapped cause", cause, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stderrors.Is(err, tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCode_Error(t *testing.T) {
+	if got := CodeRequired.Error(); got != "dxapi: required" {
+		t.Errorf("Error() = %q", got)
+	}
+}
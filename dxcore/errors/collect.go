@@ -0,0 +1,97 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package errors
+
+import stderrors "errors"
+
+// Validator is implemented by every model: Validate reports the first
+// violation it finds, or nil.
+type Validator interface {
+	Validate() error
+}
+
+// FullValidator is implemented by composite models that can also report
+// every violation at once. ValidateFull returns nil when Validate does, and
+// otherwise an errors.Join of one error per violation, each rooted at the
+// model as Validate would root it. Use Violations to list them.
+type FullValidator interface {
+	Validator
+	ValidateFull() error
+}
+
+// Check is one independent validation step of a value, typically covering
+// a single field. A Check stops at the first problem in its field.
+type Check func() error
+
+// RunChecks runs checks in order. When full is false it returns the first
+// error, so that Validate keeps failing fast; otherwise it runs every check
+// and returns their errors joined with errors.Join, or nil.
+func RunChecks(full bool, checks ...Check) error {
+	var errs []error
+	for _, check := range checks {
+		if err := check(); err != nil {
+			if !full {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+	return stderrors.Join(errs...)
+}
+
+// Each runs check for the indices 0 to n-1 the way RunChecks runs checks. It
+// validates the elements of a slice field.
+func Each(full bool, n int, check func(i int) error) error {
+	var errs []error
+	for i := 0; i < n; i++ {
+		if err := check(i); err != nil {
+			if !full {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+	return stderrors.Join(errs...)
+}
+
+// ValidateMode calls v.ValidateFull when full is set and v implements
+// FullValidator, and v.Validate otherwise. Composite models use it to
+// validate their fields in the mode they were validated in.
+func ValidateMode(v Validator, full bool) error {
+	if fv, ok := v.(FullValidator); ok && full {
+		return fv.ValidateFull()
+	}
+	return v.Validate()
+}
+
+// Violations flattens err, as returned by ValidateFull or errors.Join, into
+// the individual errors it carries, in order. It returns nil for a nil err
+// and a single-element slice for any other error.
+func Violations(err error) []error {
+	if err == nil {
+		return nil
+	}
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{err}
+	}
+	var out []error
+	for _, e := range joined.Unwrap() {
+		out = append(out, Violations(e)...)
+	}
+	return out
+}
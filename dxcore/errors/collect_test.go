@@ -0,0 +1,125 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package errors
+
+import (
+	stderrors "errors"
+	"testing"
+)
+
+type fullValidator struct{ errs []error }
+
+func (v fullValidator) Validate() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs[0]
+}
+
+func (v fullValidator) ValidateFull() error {
+	return stderrors.Join(v.errs...)
+}
+
+func TestRunChecks(t *testing.T) {
+	first := &ValidationError{Code: CodeRequired, Type: "T", Field: "A"}
+	second := &ValidationError{Code: CodeTooLong, Type: "T", Field: "B"}
+	ran := 0
+	checks := []Check{
+		func() error { ran++; return first },
+		func() error { ran++; return nil },
+		func() error { ran++; return second },
+	}
+
+	if err := RunChecks(false, checks...); err != first || ran != 1 {
+		t.Errorf("RunChecks(false) = %v after %d checks, want first error after 1", err, ran)
+	}
+
+	ran = 0
+	err := RunChecks(true, checks...)
+	if ran != 3 {
+		t.Errorf("RunChecks(true) ran %d checks, want 3", ran)
+	}
+	got := Violations(err)
+	if len(got) != 2 || got[0] != first || got[1] != second {
+		t.Errorf("Violations() = %v, want [first second]", got)
+	}
+
+	if err := RunChecks(true, func() error { return nil }); err != nil {
+		t.Errorf("RunChecks() = %v, want nil", err)
+	}
+}
+
+func TestEach(t *testing.T) {
+	check := func(i int) error {
+		if i%2 == 1 {
+			return &ValidationError{Type: "T", Field: "X"}
+		}
+		return nil
+	}
+	if got := Violations(Each(false, 5, check)); len(got) != 1 {
+		t.Errorf("Each(false) reported %d errors, want 1", len(got))
+	}
+	if got := Violations(Each(true, 5, check)); len(got) != 2 {
+		t.Errorf("Each(true) reported %d errors, want 2", len(got))
+	}
+	if err := Each(true, 0, check); err != nil {
+		t.Errorf("Each(0) = %v, want nil", err)
+	}
+}
+
+func TestValidateMode(t *testing.T) {
+	v := fullValidator{errs: []error{CodeRequired, CodeTooLong}}
+	if got := Violations(ValidateMode(v, false)); len(got) != 1 {
+		t.Errorf("ValidateMode(false) reported %d errors, want 1", len(got))
+	}
+	if got := Violations(ValidateMode(v, true)); len(got) != 2 {
+		t.Errorf("ValidateMode(true) reported %d errors, want 2", len(got))
+	}
+}
+
+func TestNest_Joined(t *testing.T) {
+	err := Nest(stderrors.Join(
+		&ValidationError{Code: CodeRequired, Type: "Signature", Field: "Name"},
+		&ValidationError{Code: CodeFormat, Type: "Signature", Field: "Email"},
+	), "Commit", "Author")
+
+	got := Violations(err)
+	want := []string{"Commit.Author.Name", "Commit.Author.Email"}
+	if len(got) != len(want) {
+		t.Fatalf("Violations() = %v, want %d errors", got, len(want))
+	}
+	for i, e := range got {
+		var ve *ValidationError
+		if !stderrors.As(e, &ve) || ve.Path() != want[i] {
+			t.Errorf("violation %d = %v, want path %q", i, e, want[i])
+		}
+	}
+}
+
+func TestViolations(t *testing.T) {
+	if Violations(nil) != nil {
+		t.Error("Violations(nil) must be nil")
+	}
+	single := &ValidationError{Type: "T"}
+	if got := Violations(single); len(got) != 1 || got[0] != single {
+		t.Errorf("Violations(single) = %v", got)
+	}
+	nested := stderrors.Join(single, stderrors.Join(CodeRequired, CodeFormat))
+	if got := Violations(nested); len(got) != 3 {
+		t.Errorf("Violations(nested) = %v, want 3 errors", got)
+	}
+}
@@ -52,6 +52,10 @@
 //     Use this in Validate() methods to report constraint violations,
 //     missing required fields, or invalid field values.
 //
+//   - UnknownFieldError
+//     Returned by strict decoding when a document contains a field that the
+//     target type does not define, typically a typo in a configuration file.
+//
 // # Usage
 //
 // Each package that defines enum-like types can use these error types
@@ -75,7 +79,10 @@
 //	type ParseError = errors.ParseError
 package errors
 
-import "strconv"
+import (
+	"fmt"
+	"strconv"
+)
 
 // ParseError is returned when parsing a string into a strongly typed enum-like
 // value fails.
@@ -109,6 +116,15 @@ type ParseError struct {
 
 	// Value is the invalid textual representation that was provided.
 	Value string
+
+	// Line and Column optionally locate the error within a multi-line input.
+	// Both are 1-based, Column counts Unicode code points, and both are zero
+	// when the position is unknown or irrelevant.
+	Line, Column int
+
+	// Reason is an optional short, human-readable explanation of the
+	// failure.
+	Reason string
 }
 
 // Error implements the error interface for ParseError.
@@ -117,14 +133,23 @@ type ParseError struct {
 //
 //	"dxapi: invalid {Type} value: {Value}"
 //
-// For example:
+// followed by " at line {Line}, column {Column}" when Line is set, and by
+// ": {Reason}" when Reason is set. For example:
 //
 //	"dxapi: invalid Bump value: unknown"
+//	"dxapi: invalid Message value: U+202E at line 1, column 12: bidirectional control character"
 //
 // The format is intentionally stable so that callers can rely on it for
 // diagnostics, while still preferring type assertions where possible.
 func (e *ParseError) Error() string {
-	return "dxapi: invalid " + e.Type + " value: " + e.Value
+	msg := "dxapi: invalid " + e.Type + " value: " + e.Value
+	if e.Line > 0 {
+		msg += " at line " + strconv.Itoa(e.Line) + ", column " + strconv.Itoa(e.Column)
+	}
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	return msg
 }
 
 // MarshalError is returned when marshaling a typed value fails due to it being
@@ -226,6 +251,11 @@ type UnmarshalError struct {
 	// "unknown value 'foo'") rather than repeating the type name; the type
 	// name is already available in the Type field and reflected in Error().
 	Reason string
+
+	// Err optionally contains the underlying error, for example the
+	// *ValidationError of a decoded value that failed validation. It is
+	// returned by Unwrap.
+	Err error
 }
 
 // Error implements the error interface for UnmarshalError.
@@ -245,21 +275,36 @@ func (e *UnmarshalError) Error() string {
 	return "dxapi: cannot unmarshal " + e.Type + ": " + e.Reason
 }
 
+// Unwrap returns Err.
+func (e *UnmarshalError) Unwrap() error {
+	return e.Err
+}
+
 // ValidationError is returned when validation of a model type fails.
 //
-// Type identifies the logical name of the type being validated (for example,
-// "Commit", "Ref"), Field optionally identifies which field failed validation,
-// Reason provides a human-readable explanation of the validation failure, and
-// Value optionally contains the problematic value that failed validation.
+// Code classifies the failure with a stable identifier (see Code), Type
+// identifies the logical name of the type being validated (for example,
+// "Commit", "Ref"), Field optionally identifies which field failed
+// validation as a path relative to Type (for example, "Changes[3].Path"),
+// Reason provides a human-readable explanation of the validation failure,
+// and Value optionally contains the problematic value that failed
+// validation. Err optionally holds the underlying cause.
 //
 // This error is used by Validate() methods in model types to report
 // constraint violations, missing required fields, or invalid field values.
+// Validate methods of composite types re-root the errors of their fields
+// with Nest, so that the error returned for a commit whose fourth file
+// change has an empty path reads "Commit.Changes[3].Path".
+//
+// ValidationError supports errors.Is with a Code or with a *ValidationError
+// pattern (see Is), and errors.As with *ValidationError.
 //
 // # Example
 //
 //	func (c Commit) Validate() error {
 //	    if c.Hash.IsZero() {
 //	        return &errors.ValidationError{
+//	            Code:   errors.CodeRequired,
 //	            Type:   "Commit",
 //	            Field:  "Hash",
 //	            Reason: "must not be empty",
@@ -268,11 +313,17 @@ func (e *UnmarshalError) Error() string {
 //	    return nil
 //	}
 type ValidationError struct {
+	// Code is the stable classification of the failure. It MAY be empty in
+	// errors constructed by code predating Code; Nest and the model
+	// packages always set it.
+	Code Code
+
 	// Type is the logical name of the type being validated.
 	Type string
 
-	// Field is the name of the field that failed validation.
-	// May be empty if the error applies to the entire type.
+	// Field is the path of the field that failed validation, relative to
+	// Type, using "." between fields and "[i]" for slice elements. May be
+	// empty if the error applies to the entire type.
 	Field string
 
 	// Reason is a short, human-readable explanation of why validation failed.
@@ -281,6 +332,9 @@ type ValidationError struct {
 	// Value optionally contains the invalid value.
 	// May be nil if not applicable or if the value should not be logged.
 	Value any
+
+	// Err optionally contains the underlying error, returned by Unwrap.
+	Err error
 }
 
 // Error implements the error interface for ValidationError.
@@ -300,3 +354,90 @@ func (e *ValidationError) Error() string {
 	}
 	return "dxapi: invalid " + e.Type + ": " + e.Reason
 }
+
+// Unwrap returns Err.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Path returns the full path of the failing field, Type followed by Field,
+// for example "Commit.Changes[3].Path".
+func (e *ValidationError) Path() string {
+	return JoinPath(e.Type, e.Field)
+}
+
+// Is reports whether e matches target. A Code target matches errors with
+// that Code. A *ValidationError target is a pattern: each of its Code, Type
+// and Field that is non-empty MUST equal the corresponding field of e. For
+// example:
+//
+//	errors.Is(err, errors.CodeTooLong)
+//	errors.Is(err, &errors.ValidationError{Type: "Commit", Field: "Hash"})
+func (e *ValidationError) Is(target error) bool {
+	switch t := target.(type) {
+	case Code:
+		return e.Code == t
+	case *ValidationError:
+		return (t.Code == "" || t.Code == e.Code) &&
+			(t.Type == "" || t.Type == e.Type) &&
+			(t.Field == "" || t.Field == e.Field)
+	}
+	return false
+}
+
+// UnknownFieldError is returned by strict decoding (for example,
+// model.FromYAMLStrict) when a document contains a field that the target
+// type does not define.
+//
+// Type identifies the logical type being decoded (for example, "Commit"),
+// Field is the path of the unknown field relative to Type using the keys of
+// the document (for example, "changes[1].pth"), and Key is the offending key
+// itself. Line and Column locate the key in the document, starting at 1;
+// they are zero when the position is unknown. Suggestion holds the closest
+// known field name when one is close enough to be a likely typo, and is
+// empty otherwise.
+//
+// # Example
+//
+//	// "dxapi: unknown field stratgey in Config at line 3, column 1; did you mean \"strategy\"?"
+//	&errors.UnknownFieldError{
+//	    Type:       "Config",
+//	    Field:      "stratgey",
+//	    Key:        "stratgey",
+//	    Line:       3,
+//	    Column:     1,
+//	    Suggestion: "strategy",
+//	}
+type UnknownFieldError struct {
+	// Type is the logical name of the type being decoded.
+	Type string
+
+	// Field is the path of the unknown field, relative to Type.
+	Field string
+
+	// Key is the unknown key as written in the document.
+	Key string
+
+	// Line and Column locate Key in the document, starting at 1, or are
+	// zero when unknown.
+	Line, Column int
+
+	// Suggestion is the known field name closest to Key, or empty.
+	Suggestion string
+}
+
+// Error implements the error interface for UnknownFieldError.
+//
+// The error message format is:
+//
+//	"dxapi: unknown field {Field} in {Type}[ at line {Line}, column {Column}][; did you mean "{Suggestion}"?]"
+func (e *UnknownFieldError) Error() string {
+	msg := "dxapi: unknown field " + e.Field + " in " + e.Type
+	if e.Line > 0 {
+		msg += fmt.Sprintf(" at line %d, column %d", e.Line, e.Column)
+	}
+	if e.Suggestion != "" {
+		msg += fmt.Sprintf("; did you mean %q?", e.Suggestion)
+	}
+	return msg
+}
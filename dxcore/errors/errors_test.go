@@ -44,6 +44,16 @@ func TestParseError_Error(t *testing.T) {
 			&ParseError{Type: "Mode", Value: ""},
 			"dxapi: invalid Mode value: ",
 		},
+		{
+			"position and reason",
+			&ParseError{Type: "Message", Value: "U+202E", Line: 3, Column: 7, Reason: "bidirectional control character"},
+			"dxapi: invalid Message value: U+202E at line 3, column 7: bidirectional control character",
+		},
+		{
+			"reason without position",
+			&ParseError{Type: "Scope", Value: "x", Reason: "too short"},
+			"dxapi: invalid Scope value: x: too short",
+		},
 	}
 
 	for _, tt := range tests {
@@ -150,9 +160,42 @@ func TestUnmarshalError_Error(t *testing.T) {
 	}
 }
 
+func TestUnknownFieldError_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *UnknownFieldError
+		want string
+	}{
+		{
+			"with position and suggestion",
+			&UnknownFieldError{Type: "Config", Field: "stratgey", Key: "stratgey", Line: 3, Column: 1, Suggestion: "strategy"},
+			`dxapi: unknown field stratgey in Config at line 3, column 1; did you mean "strategy"?`,
+		},
+		{
+			"nested without suggestion",
+			&UnknownFieldError{Type: "Commit", Field: "author.xyz", Key: "xyz", Line: 4, Column: 3},
+			"dxapi: unknown field author.xyz in Commit at line 4, column 3",
+		},
+		{
+			"without position",
+			&UnknownFieldError{Type: "Commit", Field: "mesage", Key: "mesage", Suggestion: "message"},
+			`dxapi: unknown field mesage in Commit; did you mean "message"?`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("UnknownFieldError.Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestErrors_Implements_Error_Interface(t *testing.T) {
 	// Verify that all error types implement error interface
 	var _ error = (*ParseError)(nil)
 	var _ error = (*MarshalError)(nil)
 	var _ error = (*UnmarshalError)(nil)
+	var _ error = (*UnknownFieldError)(nil)
 }
@@ -0,0 +1,115 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package lint checks commits against repository conventions that go beyond
+// the syntax enforced by conventional.ParseMessage. Each check is a Rule that
+// reports Findings; Run applies a set of rules to a range of commits.
+package lint
+
+import (
+	"fmt"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+// Severity ranks findings.
+type Severity int
+
+const (
+	// SeverityWarning marks a finding that should be looked at but does not
+	// make the commit unacceptable.
+	SeverityWarning Severity = iota
+
+	// SeverityError marks a finding that makes the commit unacceptable.
+	SeverityError
+)
+
+// String returns "warning" or "error", or "unknown" for undefined values.
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// Finding is a single problem reported by a Rule.
+type Finding struct {
+	// Rule is the Name of the rule that reported the finding.
+	Rule string
+
+	// Severity ranks the finding.
+	Severity Severity
+
+	// Commit is the hash of the offending commit.
+	Commit git.Hash
+
+	// Message describes the problem.
+	Message string
+}
+
+// String formats the finding as "<short hash>: <severity>: <rule>: <message>".
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s: %s", f.Commit.Short(), f.Severity, f.Rule, f.Message)
+}
+
+// Rule is a single lint check.
+type Rule interface {
+	// Name returns the stable identifier of the rule, for example
+	// "scope-mismatch".
+	Name() string
+
+	// Check inspects a commit together with its parsed message and returns
+	// the problems found, or nil.
+	Check(c git.Commit, m conventional.Message) []Finding
+}
+
+// RawRule is a Rule that inspects the raw commit only. Run applies it to
+// every commit, including those whose message is not a Conventional Commit,
+// so that checks such as UnicodeSafety cannot be bypassed by a malformed
+// header.
+type RawRule interface {
+	Rule
+
+	// CheckRaw inspects a commit and returns the problems found, or nil.
+	CheckRaw(c git.Commit) []Finding
+}
+
+// Run applies rules to commits and returns the findings in commit order.
+// A RawRule is applied to every commit; any other rule only to commits
+// whose message parses as a Conventional Commit. Reporting the messages
+// that do not parse is the job of message validation.
+func Run(commits []git.Commit, rules ...Rule) []Finding {
+	var findings []Finding
+	for _, c := range commits {
+		m, err := conventional.ParseMessage(c.Message)
+		for _, r := range rules {
+			if rr, ok := r.(RawRule); ok {
+				findings = append(findings, rr.CheckRaw(c)...)
+				continue
+			}
+			if err != nil {
+				continue
+			}
+			findings = append(findings, r.Check(c, m)...)
+		}
+	}
+	return findings
+}
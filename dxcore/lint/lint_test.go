@@ -0,0 +1,67 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package lint_test
+
+import (
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/lint"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+// hashOf builds a deterministic 40-character hash from a short seed.
+func hashOf(seed string) git.Hash {
+	return git.Hash(seed + strings.Repeat("0", 40-len(seed)))
+}
+
+func commit(seed, message string) git.Commit {
+	return git.Commit{Hash: hashOf(seed), Message: message}
+}
+
+// subjectRule reports every commit whose subject contains "wip".
+type subjectRule struct{}
+
+func (subjectRule) Name() string { return "no-wip" }
+
+func (r subjectRule) Check(c git.Commit, m conventional.Message) []lint.Finding {
+	if !strings.Contains(m.Subject.String(), "wip") {
+		return nil
+	}
+	return []lint.Finding{{Rule: r.Name(), Severity: lint.SeverityError, Commit: c.Hash, Message: "work in progress"}}
+}
+
+func TestRun(t *testing.T) {
+	commits := []git.Commit{
+		commit("aaaa", "fix: wip"),
+		commit("bbbb", "not conventional wip"),
+		commit("cccc", "feat: done"),
+		commit("dddd", "chore: more wip"),
+	}
+
+	findings := lint.Run(commits, subjectRule{})
+	if len(findings) != 2 {
+		t.Fatalf("Run() = %v, want 2 findings", findings)
+	}
+	if findings[0].Commit != hashOf("aaaa") || findings[1].Commit != hashOf("dddd") {
+		t.Errorf("Run() commits = %s, %s", findings[0].Commit, findings[1].Commit)
+	}
+	if got := findings[0].String(); got != "aaaa000: error: no-wip: work in progress" {
+		t.Errorf("String() = %q", got)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/scopes"
+)
+
+// ScopeMismatch warns when the scopes declared in a commit header match none
+// of the scopes inferred from the files the commit changes, for example
+// "fix(api): ..." touching only files under cli/.
+//
+// Commits without a declared scope, and commits whose changed files are not
+// covered by Rules, are not reported.
+type ScopeMismatch struct {
+	// Rules maps repository paths to scopes (see scopes.Rule).
+	Rules []scopes.Rule
+}
+
+// Name returns "scope-mismatch".
+func (r ScopeMismatch) Name() string {
+	return "scope-mismatch"
+}
+
+// Check implements Rule.
+func (r ScopeMismatch) Check(c git.Commit, m conventional.Message) []Finding {
+	if m.Scope.IsZero() {
+		return nil
+	}
+	inferred := scopes.Infer(c.Changes, r.Rules)
+	if len(inferred) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(inferred))
+	for i, sc := range inferred {
+		for _, declared := range m.ScopeList() {
+			if scopes.Covers(declared, sc.Scope) {
+				return nil
+			}
+		}
+		names[i] = sc.Scope.String()
+	}
+
+	declared := make([]string, 0, len(m.ScopeList()))
+	for _, sc := range m.ScopeList() {
+		declared = append(declared, sc.String())
+	}
+	return []Finding{{
+		Rule:     r.Name(),
+		Severity: SeverityWarning,
+		Commit:   c.Hash,
+		Message:  fmt.Sprintf("scope %q does not match the changed files (touches %s)", strings.Join(declared, ","), strings.Join(names, ", ")),
+	}}
+}
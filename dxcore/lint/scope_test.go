@@ -0,0 +1,67 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package lint_test
+
+import (
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/lint"
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/scopes"
+)
+
+func TestScopeMismatch(t *testing.T) {
+	rule := lint.ScopeMismatch{Rules: []scopes.Rule{
+		{Pattern: "api", Scope: "api"},
+		{Pattern: "cli", Scope: "cli"},
+		{Pattern: "core", Scope: "core"},
+	}}
+
+	tests := []struct {
+		name    string
+		message string
+		files   []string
+		want    bool
+	}{
+		{"matching", "fix(api): x", []string{"api/a.go"}, false},
+		{"mismatch", "fix(api): x", []string{"cli/main.go"}, true},
+		{"one_of_several", "fix(cli): x", []string{"api/a.go", "cli/main.go"}, false},
+		{"child_scope", "fix(core/io): x", []string{"core/io/r.go"}, false},
+		{"no_scope", "fix: x", []string{"cli/main.go"}, false},
+		{"uncovered_files", "fix(api): x", []string{"README.md"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := commit("aaaa", tt.message)
+			for _, f := range tt.files {
+				c.Changes = append(c.Changes, git.FileChange{Path: f, Kind: git.FileChangeModified})
+			}
+			findings := lint.Run([]git.Commit{c}, rule)
+			if got := len(findings) > 0; got != tt.want {
+				t.Fatalf("findings = %v, want reported=%v", findings, tt.want)
+			}
+			if tt.want {
+				f := findings[0]
+				if f.Rule != "scope-mismatch" || f.Severity != lint.SeverityWarning || !strings.Contains(f.Message, "cli") {
+					t.Errorf("finding = %+v", f)
+				}
+			}
+		})
+	}
+}
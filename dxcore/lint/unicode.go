@@ -0,0 +1,74 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package lint
+
+import (
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+// UnicodeSafety reports characters that can make a commit render
+// differently from what its reviewers saw: bidirectional controls ("Trojan
+// Source"), invisible and control characters, and mixed-script words that
+// suggest homoglyphs (see model.ScanText). It checks the raw commit
+// message and the author and committer names, which end up in changelogs
+// and release notes.
+//
+// Each offending character is reported as a separate error whose message is
+// the diagnostic of the corresponding *errors.ParseError, including its line
+// and column.
+type UnicodeSafety struct{}
+
+// Name returns "unicode-safety".
+func (r UnicodeSafety) Name() string {
+	return "unicode-safety"
+}
+
+// Check implements Rule. It ignores the parsed message and calls CheckRaw.
+func (r UnicodeSafety) Check(c git.Commit, _ conventional.Message) []Finding {
+	return r.CheckRaw(c)
+}
+
+// CheckRaw implements RawRule, so that Run also checks commits whose
+// message is not a Conventional Commit.
+func (r UnicodeSafety) CheckRaw(c git.Commit) []Finding {
+	var findings []Finding
+	for _, field := range []struct{ typ, text string }{
+		{"Commit.Message", c.Message},
+		{"Commit.Author", c.Author.Name},
+		{"Commit.Committer", c.Committer.Name},
+	} {
+		err := model.CheckText(field.typ, field.text)
+		if err == nil {
+			continue
+		}
+		errs := []error{err}
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			errs = joined.Unwrap()
+		}
+		for _, e := range errs {
+			findings = append(findings, Finding{
+				Rule:     r.Name(),
+				Severity: SeverityError,
+				Commit:   c.Hash,
+				Message:  e.Error(),
+			})
+		}
+	}
+	return findings
+}
@@ -0,0 +1,74 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package lint_test
+
+import (
+	"reflect"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/lint"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+func TestUnicodeSafety(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		author  string
+		want    []string
+	}{
+		{"clean", "feat: add search\n\nWorks with caf\u00e9 and \u65b0\u6a5f\u80fd.", "Jane Doe", nil},
+		{
+			"bidi_in_body",
+			"fix: check access\n\nif admin \u202e{ return }\u202c",
+			"Jane Doe",
+			[]string{
+				"dxapi: invalid Commit.Message value: U+202E at line 3, column 10: bidirectional control character",
+				"dxapi: invalid Commit.Message value: U+202C at line 3, column 21: bidirectional control character",
+			},
+		},
+		{
+			"homoglyph_author",
+			"fix: x",
+			"J\u0430ne Doe",
+			[]string{"dxapi: invalid Commit.Author value: U+0430 at line 1, column 2: mixed-script word (possible homoglyph)"},
+		},
+		{
+			"not_conventional",
+			"update \u202eadmin check",
+			"Jane Doe",
+			[]string{"dxapi: invalid Commit.Message value: U+202E at line 1, column 8: bidirectional control character"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := commit("aaaa", tt.message)
+			c.Author.Name = tt.author
+			var got []string
+			for _, f := range lint.Run([]git.Commit{c}, lint.UnicodeSafety{}) {
+				if f.Rule != "unicode-safety" || f.Severity != lint.SeverityError {
+					t.Errorf("finding = %+v", f)
+				}
+				got = append(got, f.Message)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findings = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package change
 
 import (
 	"encoding/json"
+	"log/slog"
 
 	"dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
@@ -179,6 +180,11 @@ func (b Bump) Redacted() string {
 	return b.String()
 }
 
+// LogValue implements slog.LogValuer, logging the Bump as its Redacted form.
+func (b Bump) LogValue() slog.Value {
+	return slog.StringValue(b.Redacted())
+}
+
 // IsZero reports whether the Bump has its zero value.
 //
 // For Bump (an enum type), the zero value is BumpNone (constant 0).
@@ -226,8 +232,8 @@ func (b Bump) Equal(other any) bool {
 func (b Bump) Validate() error {
 	if !b.Valid() {
 		return &errors.ValidationError{
+			Code:   errors.CodeOutOfRange,
 			Type:   "Bump",
-			Field:  "",
 			Reason: "invalid Bump value",
 			Value:  int(b),
 		}
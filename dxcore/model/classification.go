@@ -0,0 +1,288 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package model
+
+import (
+	"encoding/json"
+	"log/slog"
+
+	"dirpx.dev/dxrel/dxcore/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// ClassificationMode controls which commits of a range dxrel classifies and
+// which text it reads the Conventional Commit message from.
+//
+// The right choice depends on how a repository merges changes:
+//
+//   - Repositories that rebase or fast-forward keep every authored commit on
+//     the mainline, so every commit is classified on its own.
+//
+//   - Repositories that use merge commits ("Merge pull request #12 from ...")
+//     bring the individual branch commits into the range as second-parent
+//     history. Counting them and the merge would double-count changes, so
+//     only the first-parent chain should be considered.
+//
+//   - Repositories that squash-merge produce one commit per pull request
+//     whose body lists the original commits ("* feat: ...", "* fix: ...").
+//     Classifying only the title would hide everything but one change.
+//
+// ClassificationMode encapsulates this choice so that the computed bump
+// reflects the real changes regardless of merge style.
+type ClassificationMode int
+
+const (
+	// ClassifyAll classifies every commit in the range individually using
+	// its own message. This is the default and matches linear histories.
+	ClassifyAll ClassificationMode = iota
+
+	// ClassifyFirstParent classifies only the commits reachable from the
+	// range tip by following first parents. Merge commits on the mainline are
+	// classified by their own message; commits brought in by second parents
+	// are ignored.
+	ClassifyFirstParent
+
+	// ClassifyPRTitle behaves like ClassifyFirstParent but classifies merge
+	// commits by the pull request title that GitHub and GitLab write as the
+	// first body paragraph of the merge commit, rather than by the
+	// "Merge pull request #N" summary line.
+	ClassifyPRTitle
+
+	// ClassifySquash expands squash-merge commits into one virtual message
+	// per Conventional Commit bullet line ("* feat: ...") found in the body.
+	// Commits without such bullets are classified by their own header.
+	ClassifySquash
+)
+
+// Compile-time check that ClassificationMode implements model.Model interface.
+var _ Model = (*ClassificationMode)(nil)
+
+// String constants for ClassificationMode values used in serialization,
+// parsing, and human-facing output.
+//
+// These constants define the canonical external representation of
+// ClassificationMode and MAY be used in configuration files, CLI flags, and
+// JSON/YAML payloads. Changing any of these strings is a breaking change for
+// consumers that rely on textual configuration.
+const (
+	ClassifyAllStr         = "all"
+	ClassifyFirstParentStr = "first-parent"
+	ClassifyPRTitleStr     = "pr-title"
+	ClassifySquashStr      = "squash"
+)
+
+// String returns the canonical string representation of the
+// ClassificationMode value.
+//
+// The mapping is:
+//
+//	ClassifyAll         -> "all"
+//	ClassifyFirstParent -> "first-parent"
+//	ClassifyPRTitle     -> "pr-title"
+//	ClassifySquash      -> "squash"
+//
+// If the value is not one of the defined constants, String returns "unknown".
+func (m ClassificationMode) String() string {
+	switch m {
+	case ClassifyAll:
+		return ClassifyAllStr
+	case ClassifyFirstParent:
+		return ClassifyFirstParentStr
+	case ClassifyPRTitle:
+		return ClassifyPRTitleStr
+	case ClassifySquash:
+		return ClassifySquashStr
+	default:
+		return "unknown"
+	}
+}
+
+// ParseClassificationMode converts a textual representation into a
+// ClassificationMode value.
+//
+// Like ParseStrategy, the function accepts a few stylistic variants in
+// addition to the canonical kebab-case form:
+//
+//	"all", "All", "ALL"                                       -> ClassifyAll
+//	"first-parent", "FirstParent", "first_parent", "FIRST_PARENT" -> ClassifyFirstParent
+//	"pr-title", "PRTitle", "pr_title", "PR_TITLE"             -> ClassifyPRTitle
+//	"squash", "Squash", "SQUASH"                              -> ClassifySquash
+//
+// If the input does not match any known value, ParseClassificationMode
+// returns a non-nil *ParseError and the returned value MUST NOT be used.
+func ParseClassificationMode(str string) (ClassificationMode, error) {
+	switch str {
+	case ClassifyAllStr, "All", "ALL":
+		return ClassifyAll, nil
+	case ClassifyFirstParentStr, "FirstParent", "first_parent", "FIRST_PARENT":
+		return ClassifyFirstParent, nil
+	case ClassifyPRTitleStr, "PRTitle", "pr_title", "PR_TITLE":
+		return ClassifyPRTitle, nil
+	case ClassifySquashStr, "Squash", "SQUASH":
+		return ClassifySquash, nil
+	default:
+		return ClassifyAll, &errors.ParseError{Type: "ClassificationMode", Value: str}
+	}
+}
+
+// Valid reports whether the ClassificationMode value is one of the defined
+// constants.
+func (m ClassificationMode) Valid() bool {
+	return m >= ClassifyAll && m <= ClassifySquash
+}
+
+// MarshalJSON implements json.Marshaler for ClassificationMode.
+//
+// A valid value is serialized as its canonical string representation. If the
+// value is not valid, MarshalJSON returns a *MarshalError.
+func (m ClassificationMode) MarshalJSON() ([]byte, error) {
+	if !m.Valid() {
+		return nil, &errors.MarshalError{Type: "ClassificationMode", Value: int(m)}
+	}
+	return []byte(`"` + m.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler for ClassificationMode.
+//
+// Both the string forms accepted by ParseClassificationMode and the numeric
+// constants (0-3, in declaration order) are accepted. Invalid input yields an
+// *UnmarshalError or the underlying *ParseError.
+func (m *ClassificationMode) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return &errors.UnmarshalError{Type: "ClassificationMode", Data: data, Reason: "empty data"}
+	}
+
+	// Try string format first.
+	if data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return &errors.UnmarshalError{Type: "ClassificationMode", Data: data, Reason: err.Error()}
+		}
+		parsed, err := ParseClassificationMode(str)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	}
+
+	// Fallback to numeric format.
+	var i int
+	if err := json.Unmarshal(data, &i); err != nil {
+		return &errors.UnmarshalError{Type: "ClassificationMode", Data: data, Reason: err.Error()}
+	}
+	*m = ClassificationMode(i)
+	if !m.Valid() {
+		return &errors.UnmarshalError{Type: "ClassificationMode", Data: data, Reason: "invalid numeric value"}
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for ClassificationMode.
+func (m ClassificationMode) MarshalText() ([]byte, error) {
+	if !m.Valid() {
+		return nil, &errors.MarshalError{Type: "ClassificationMode", Value: int(m)}
+	}
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for ClassificationMode,
+// using ParseClassificationMode as the single source of truth.
+func (m *ClassificationMode) UnmarshalText(text []byte) error {
+	parsed, err := ParseClassificationMode(string(text))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// TypeName returns "ClassificationMode", the name of the type for logging and
+// debugging.
+func (m ClassificationMode) TypeName() string {
+	return "ClassificationMode"
+}
+
+// Redacted returns the same string representation as String(); the value
+// contains no sensitive information.
+func (m ClassificationMode) Redacted() string {
+	return m.String()
+}
+
+// LogValue implements slog.LogValuer, logging the ClassificationMode as its Redacted form.
+func (m ClassificationMode) LogValue() slog.Value {
+	return slog.StringValue(m.Redacted())
+}
+
+// IsZero reports whether the ClassificationMode has its zero value
+// (ClassifyAll). The zero value is valid and is the default mode.
+func (m ClassificationMode) IsZero() bool {
+	return m == ClassifyAll
+}
+
+// Equal reports whether this ClassificationMode is equal to another value,
+// which MAY be a ClassificationMode or a *ClassificationMode.
+func (m ClassificationMode) Equal(other any) bool {
+	switch v := other.(type) {
+	case ClassificationMode:
+		return m == v
+	case *ClassificationMode:
+		if v == nil {
+			return false
+		}
+		return m == *v
+	default:
+		return false
+	}
+}
+
+// Validate checks whether the ClassificationMode value is one of the defined
+// constants.
+func (m ClassificationMode) Validate() error {
+	if !m.Valid() {
+		return &errors.ValidationError{
+			Code:   errors.CodeOutOfRange,
+			Type:   "ClassificationMode",
+			Reason: "invalid ClassificationMode value",
+			Value:  int(m),
+		}
+	}
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler for ClassificationMode.
+func (m ClassificationMode) MarshalYAML() (any, error) {
+	if !m.Valid() {
+		return nil, &errors.MarshalError{Type: "ClassificationMode", Value: int(m)}
+	}
+	return m.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler for ClassificationMode, resolving
+// string scalars via ParseClassificationMode.
+func (m *ClassificationMode) UnmarshalYAML(node *yaml.Node) error {
+	var str string
+	if err := node.Decode(&str); err != nil {
+		return &errors.UnmarshalError{Type: "ClassificationMode", Data: []byte(node.Value), Reason: err.Error()}
+	}
+	parsed, err := ParseClassificationMode(str)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
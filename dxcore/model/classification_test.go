@@ -0,0 +1,148 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestClassificationMode_String(t *testing.T) {
+	tests := []struct {
+		mode ClassificationMode
+		want string
+	}{
+		{ClassifyAll, "all"},
+		{ClassifyFirstParent, "first-parent"},
+		{ClassifyPRTitle, "pr-title"},
+		{ClassifySquash, "squash"},
+		{ClassificationMode(99), "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.mode.String(); got != tt.want {
+				t.Errorf("ClassificationMode.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseClassificationMode(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ClassificationMode
+		wantErr bool
+	}{
+		{"all", ClassifyAll, false},
+		{"first-parent", ClassifyFirstParent, false},
+		{"FirstParent", ClassifyFirstParent, false},
+		{"first_parent", ClassifyFirstParent, false},
+		{"pr-title", ClassifyPRTitle, false},
+		{"PR_TITLE", ClassifyPRTitle, false},
+		{"squash", ClassifySquash, false},
+		{"", ClassifyAll, true},
+		{"rebase", ClassifyAll, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseClassificationMode(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseClassificationMode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseClassificationMode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassificationMode_Validate(t *testing.T) {
+	if err := ClassifySquash.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+	if err := ClassificationMode(-1).Validate(); err == nil {
+		t.Error("Validate() expected error for invalid value")
+	}
+}
+
+func TestClassificationMode_JSON(t *testing.T) {
+	data, err := json.Marshal(ClassifyPRTitle)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(data) != `"pr-title"` {
+		t.Errorf("Marshal() = %s, want \"pr-title\"", data)
+	}
+
+	var m ClassificationMode
+	if err := json.Unmarshal([]byte(`"squash"`), &m); err != nil || m != ClassifySquash {
+		t.Errorf("Unmarshal(string) = %v, %v", m, err)
+	}
+	if err := json.Unmarshal([]byte(`1`), &m); err != nil || m != ClassifyFirstParent {
+		t.Errorf("Unmarshal(number) = %v, %v", m, err)
+	}
+	if err := json.Unmarshal([]byte(`7`), &m); err == nil {
+		t.Error("Unmarshal(7) expected error")
+	}
+	if _, err := json.Marshal(ClassificationMode(7)); err == nil {
+		t.Error("Marshal(7) expected error")
+	}
+}
+
+func TestClassificationMode_YAML(t *testing.T) {
+	type config struct {
+		Mode ClassificationMode `yaml:"mode"`
+	}
+
+	data, err := yaml.Marshal(config{Mode: ClassifyFirstParent})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(data) != "mode: first-parent\n" {
+		t.Errorf("Marshal() = %q", data)
+	}
+
+	var c config
+	if err := yaml.Unmarshal([]byte("mode: squash\n"), &c); err != nil || c.Mode != ClassifySquash {
+		t.Errorf("Unmarshal() = %v, %v", c.Mode, err)
+	}
+	if err := yaml.Unmarshal([]byte("mode: nope\n"), &c); err == nil {
+		t.Error("Unmarshal(nope) expected error")
+	}
+}
+
+func TestClassificationMode_Text(t *testing.T) {
+	var m ClassificationMode
+	if err := m.UnmarshalText([]byte("pr-title")); err != nil || m != ClassifyPRTitle {
+		t.Errorf("UnmarshalText() = %v, %v", m, err)
+	}
+	text, err := ClassifySquash.MarshalText()
+	if err != nil || string(text) != "squash" {
+		t.Errorf("MarshalText() = %s, %v", text, err)
+	}
+	if !ClassifyAll.IsZero() || ClassifySquash.IsZero() {
+		t.Error("IsZero() mismatch")
+	}
+	if !ClassifySquash.Equal(ClassifySquash) || ClassifySquash.Equal("squash") {
+		t.Error("Equal() mismatch")
+	}
+}
@@ -0,0 +1,247 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"mime"
+	"strconv"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// MediaType identifies a wire format supported by Marshal and Unmarshal.
+type MediaType string
+
+const (
+	// MediaTypeJSON selects JSON (see ToJSON).
+	MediaTypeJSON MediaType = "application/json"
+
+	// MediaTypeYAML selects YAML (see ToYAML).
+	MediaTypeYAML MediaType = "application/yaml"
+
+	// MediaTypeCBOR selects CBOR (RFC 8949, see ToCBOR), a compact binary
+	// encoding intended for large payloads such as the commits of a
+	// release plan over a monorepo.
+	MediaTypeCBOR MediaType = "application/cbor"
+
+	// MediaTypeTOML selects TOML (see ToTOML).
+	MediaTypeTOML MediaType = "application/toml"
+)
+
+// mediaTypeAliases maps alternative spellings to the supported media types.
+var mediaTypeAliases = map[string]MediaType{
+	"application/json":   MediaTypeJSON,
+	"text/json":          MediaTypeJSON,
+	"application/yaml":   MediaTypeYAML,
+	"application/x-yaml": MediaTypeYAML,
+	"text/yaml":          MediaTypeYAML,
+	"text/x-yaml":        MediaTypeYAML,
+	"application/cbor":   MediaTypeCBOR,
+	"application/toml":   MediaTypeTOML,
+	"text/x-toml":        MediaTypeTOML,
+}
+
+// MediaTypes returns the supported media types in order of preference.
+func MediaTypes() []MediaType {
+	return []MediaType{MediaTypeJSON, MediaTypeYAML, MediaTypeCBOR, MediaTypeTOML}
+}
+
+// ParseMediaType parses a Content-Type value such as
+// "application/json; charset=utf-8" into a supported MediaType. Parameters
+// are ignored and common aliases such as "application/x-yaml" are accepted.
+func ParseMediaType(s string) (MediaType, error) {
+	base, _, err := mime.ParseMediaType(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid media type %q: %w", s, err)
+	}
+	mt, ok := mediaTypeAliases[base]
+	if !ok {
+		return "", fmt.Errorf("unsupported media type %q", base)
+	}
+	return mt, nil
+}
+
+// Negotiate selects the media type to respond with for an Accept header
+// (RFC 9110, section 12.5.1). Each supported media type takes the quality
+// value of the most specific range matching it ("application/cbor" before
+// "application/*" before "*/*"), so "application/json;q=0, */*" excludes
+// JSON. The type with the highest quality value wins, ties going to the
+// order of MediaTypes. An empty header selects JSON. It reports false when
+// no supported media type is acceptable.
+func Negotiate(accept string) (MediaType, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return MediaTypeJSON, true
+	}
+
+	type match struct {
+		q           float64
+		specificity int
+	}
+	matches := map[MediaType]match{}
+	for _, part := range strings.Split(accept, ",") {
+		base, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil || q < 0 || q > 1 {
+				continue
+			}
+		}
+		for _, mt := range MediaTypes() {
+			specificity, ok := matchMediaRange(base, mt)
+			if !ok {
+				continue
+			}
+			if m, seen := matches[mt]; !seen || specificity > m.specificity {
+				matches[mt] = match{q: q, specificity: specificity}
+			}
+		}
+	}
+
+	var best MediaType
+	bestQ := 0.0
+	for _, mt := range MediaTypes() {
+		if m, ok := matches[mt]; ok && m.q > bestQ {
+			best, bestQ = mt, m.q
+		}
+	}
+	return best, best != ""
+}
+
+// matchMediaRange reports whether the media range r matches mt and how
+// specific the match is: 2 for an exact type or alias, 1 for "type/*" and 0
+// for "*/*".
+func matchMediaRange(r string, mt MediaType) (int, bool) {
+	switch {
+	case r == "*/*":
+		return 0, true
+	case strings.HasSuffix(r, "/*"):
+		return 1, strings.HasPrefix(string(mt), strings.TrimSuffix(r, "*"))
+	default:
+		alias, ok := mediaTypeAliases[r]
+		return 2, ok && alias == mt
+	}
+}
+
+// Marshal validates m and encodes it in the format selected by mt.
+func Marshal[T Model](m T, mt MediaType) ([]byte, error) {
+	switch mt {
+	case MediaTypeJSON:
+		return ToJSON(m)
+	case MediaTypeYAML:
+		return ToYAML(m)
+	case MediaTypeCBOR:
+		return ToCBOR(m)
+	case MediaTypeTOML:
+		return ToTOML(m)
+	default:
+		return nil, fmt.Errorf("unsupported media type %q", mt)
+	}
+}
+
+// Unmarshal decodes data in the format selected by mt into m and validates
+// the result.
+func Unmarshal[T Model](data []byte, mt MediaType, m *T) error {
+	switch mt {
+	case MediaTypeJSON:
+		return FromJSON(data, m)
+	case MediaTypeYAML:
+		return FromYAML(data, m)
+	case MediaTypeCBOR:
+		return FromCBOR(data, m)
+	case MediaTypeTOML:
+		return FromTOML(data, m)
+	default:
+		return fmt.Errorf("unsupported media type %q", mt)
+	}
+}
+
+// cborEncMode encodes maps deterministically and times with their offset
+// and full precision, so that a CBOR round trip yields the same JSON as the
+// original value. Values implementing encoding.BinaryMarshaler, such as
+// git.Hash, are encoded as byte strings holding their binary form; the model
+// packages therefore never depend on the CBOR library.
+var cborEncMode = func() cbor.EncMode {
+	opts := cbor.CoreDetEncOptions()
+	opts.Time = cbor.TimeRFC3339Nano
+	opts.TimeTag = cbor.EncTagRequired
+	opts.BinaryMarshaler = cbor.BinaryMarshalerByteString
+	mode, err := opts.EncMode()
+	if err != nil {
+		panic(err)
+	}
+	return mode
+}()
+
+// cborDecMode rejects duplicate map keys, which JSON decoding would resolve
+// silently. Byte strings are decoded with encoding.BinaryUnmarshaler and text
+// strings with encoding.TextUnmarshaler where the target implements them, so
+// that a git.Hash is accepted in its binary and in its hexadecimal form.
+var cborDecMode = func() cbor.DecMode {
+	mode, err := cbor.DecOptions{
+		DupMapKey:         cbor.DupMapKeyEnforcedAPF,
+		BinaryUnmarshaler: cbor.BinaryUnmarshalerByteString,
+		TextUnmarshaler:   cbor.TextUnmarshalerTextString,
+	}.DecMode()
+	if err != nil {
+		panic(err)
+	}
+	return mode
+}()
+
+// MarshalCBOR encodes v as CBOR the way ToCBOR does, without validation.
+// It accepts any value, such as a slice of commits or a release plan.
+//
+// Struct fields are encoded as maps keyed by their JSON names, enum-like
+// types as their integer values and values implementing
+// encoding.BinaryMarshaler, such as git hashes, as byte strings (see
+// git.Hash.MarshalBinary), which makes the encoding considerably smaller
+// than JSON.
+func MarshalCBOR(v any) ([]byte, error) {
+	return cborEncMode.Marshal(v)
+}
+
+// UnmarshalCBOR decodes CBOR produced by MarshalCBOR into v, which must be
+// a non-nil pointer. It does not validate the result.
+func UnmarshalCBOR(data []byte, v any) error {
+	return cborDecMode.Unmarshal(data, v)
+}
+
+// ToCBOR validates the model and encodes it as CBOR (see MarshalCBOR). A
+// value decoded with FromCBOR marshals to the same JSON as m.
+func ToCBOR[T Model](m T) ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("cannot marshal invalid %s: %w", m.TypeName(), err)
+	}
+	return MarshalCBOR(m)
+}
+
+// FromCBOR decodes CBOR produced by ToCBOR into m and validates the result.
+// Callers MUST check the returned error before using m.
+func FromCBOR[T Model](data []byte, m *T) error {
+	if err := UnmarshalCBOR(data, m); err != nil {
+		return fmt.Errorf("cannot unmarshal CBOR: %w", err)
+	}
+	if err := (*m).Validate(); err != nil {
+		return fmt.Errorf("unmarshaled model is invalid: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package model_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"dirpx.dev/dxrel/dxcore/engine"
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/model/semver"
+)
+
+const codecHash = git.Hash("a1b2c3d4e5f67890abcdef1234567890abcdef12")
+
+func codecCommit() *git.Commit {
+	when := time.Date(2025, 3, 4, 5, 6, 7, 890123456, time.FixedZone("", 2*60*60))
+	sig := git.Signature{Name: "Jane Doe", Email: "jane@example.com", When: when}
+	return &git.Commit{
+		Hash:      codecHash,
+		Parents:   []git.Hash{"b1b2c3d4e5f67890abcdef1234567890abcdef12"},
+		Author:    sig,
+		Committer: sig,
+		Message:   "feat(api): add x\n\nbody\n\nRefs: #1",
+		Summary:   "feat(api): add x",
+		Changes: []git.FileChange{
+			{Path: "a.go", Kind: git.FileChangeAdded},
+			{Path: "b.go", OldPath: "c.go", Kind: git.FileChangeRenamed},
+		},
+	}
+}
+
+// assertCBORRoundTrip encodes m as CBOR, decodes it and checks that the
+// decoded value marshals to the same JSON as m.
+func assertCBORRoundTrip[T model.Model](t *testing.T, m T) {
+	t.Helper()
+	want, err := model.ToJSON(m)
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	data, err := model.ToCBOR(m)
+	if err != nil {
+		t.Fatalf("ToCBOR() error = %v", err)
+	}
+	var got T
+	if err := model.FromCBOR(data, &got); err != nil {
+		t.Fatalf("FromCBOR() error = %v", err)
+	}
+	gotJSON, err := model.ToJSON(got)
+	if err != nil {
+		t.Fatalf("ToJSON(decoded) error = %v", err)
+	}
+	if !bytes.Equal(gotJSON, want) {
+		t.Errorf("CBOR round trip changed JSON form:\n got %s\nwant %s", gotJSON, want)
+	}
+}
+
+func TestCBOR_RoundTrip(t *testing.T) {
+	msg, err := conventional.ParseMessage("feat(api,cli)!: add x\n\nbody\n\nRefs: #1\nBREAKING CHANGE: gone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := codecHash
+	empty := git.Hash("")
+	sha256 := git.Hash(strings.Repeat("ab", 32))
+	strategy := model.Sequential
+	bump := change.BumpMinor
+
+	t.Run("Commit", func(t *testing.T) { assertCBORRoundTrip(t, codecCommit()) })
+	t.Run("Hash", func(t *testing.T) { assertCBORRoundTrip(t, &hash) })
+	t.Run("Hash/empty", func(t *testing.T) { assertCBORRoundTrip(t, &empty) })
+	t.Run("Hash/sha256", func(t *testing.T) { assertCBORRoundTrip(t, &sha256) })
+	t.Run("Ref", func(t *testing.T) {
+		assertCBORRoundTrip(t, &git.Ref{Name: "refs/heads/main", Kind: git.RefKindBranch, Hash: hash})
+	})
+	t.Run("CommitRange", func(t *testing.T) {
+		assertCBORRoundTrip(t, &git.CommitRange{From: git.Ref{Name: "v1.0.0", Kind: git.RefKindTag}, To: git.Ref{Name: "HEAD"}})
+	})
+	t.Run("Tag", func(t *testing.T) {
+		assertCBORRoundTrip(t, &git.Tag{Name: "v1.0.0", Object: hash, Commit: hash, Annotated: true, Message: "Release"})
+	})
+	t.Run("Message", func(t *testing.T) { assertCBORRoundTrip(t, &msg) })
+	t.Run("Strategy", func(t *testing.T) { assertCBORRoundTrip(t, &strategy) })
+	t.Run("Bump", func(t *testing.T) { assertCBORRoundTrip(t, &bump) })
+}
+
+func TestCBOR_Plan(t *testing.T) {
+	plan, err := engine.PlanRelease(semver.Version{Major: 1}, []git.Commit{
+		{Hash: codecHash, Message: "feat: add x"},
+		{Hash: "b1b2c3d4e5f67890abcdef1234567890abcdef12", Message: "fix: y\n\nRelease-As: 3.0.0-rc.1+build.5"},
+	}, engine.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := json.Marshal(plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := model.MarshalCBOR(plan)
+	if err != nil {
+		t.Fatalf("MarshalCBOR() error = %v", err)
+	}
+	var got engine.Plan
+	if err := model.UnmarshalCBOR(data, &got); err != nil {
+		t.Fatalf("UnmarshalCBOR() error = %v", err)
+	}
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(gotJSON, want) {
+		t.Errorf("CBOR round trip changed JSON form:\n got %s\nwant %s", gotJSON, want)
+	}
+}
+
+func TestCBOR_SmallerThanJSON(t *testing.T) {
+	commits := make([]git.Commit, 100)
+	for i := range commits {
+		commits[i] = *codecCommit()
+	}
+	js, err := json.Marshal(commits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cb, err := model.MarshalCBOR(commits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cb) >= len(js) {
+		t.Errorf("CBOR size = %d, want less than JSON size %d", len(cb), len(js))
+	}
+
+	var got []git.Commit
+	if err := model.UnmarshalCBOR(cb, &got); err != nil {
+		t.Fatalf("UnmarshalCBOR() error = %v", err)
+	}
+	if len(got) != len(commits) || !got[99].Equal(commits[99]) {
+		t.Errorf("UnmarshalCBOR() did not restore the commits")
+	}
+}
+
+func TestCBOR_Hash(t *testing.T) {
+	tests := []struct {
+		name    string
+		hash    git.Hash
+		wantLen int
+	}{
+		{"empty", git.Hash(""), 1},
+		{"sha1", codecHash, 1 + git.HashByteSizeSHA1},
+		{"sha256", git.Hash(strings.Repeat("ab", 32)), 2 + git.HashByteSizeSHA256},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := model.MarshalCBOR(tt.hash)
+			if err != nil {
+				t.Fatalf("MarshalCBOR() error = %v", err)
+			}
+			if len(data) != tt.wantLen {
+				t.Errorf("MarshalCBOR() length = %d, want %d (a byte string of the raw digest)", len(data), tt.wantLen)
+			}
+		})
+	}
+
+	// A text string holding the hex form is accepted and normalized.
+	text := append([]byte{0x78, 40}, strings.ToUpper(string(codecHash))...)
+	var got git.Hash
+	if err := model.UnmarshalCBOR(text, &got); err != nil {
+		t.Fatalf("UnmarshalCBOR(text) error = %v", err)
+	}
+	if got != codecHash {
+		t.Errorf("UnmarshalCBOR(text) = %q, want %q", got, codecHash)
+	}
+
+	// A byte string of the wrong length is rejected.
+	if err := model.UnmarshalCBOR([]byte{0x43, 1, 2, 3}, &got); err == nil {
+		t.Error("UnmarshalCBOR() of short digest succeeded, want error")
+	}
+	if _, err := model.MarshalCBOR(git.Hash("invalid")); err == nil {
+		t.Error("MarshalCBOR() of invalid hash succeeded, want error")
+	}
+}
+
+func TestCBOR_Invalid(t *testing.T) {
+	invalid := &git.Commit{Hash: "not-a-hash"}
+	if _, err := model.ToCBOR(invalid); err == nil {
+		t.Error("ToCBOR() of invalid commit succeeded, want error")
+	}
+
+	// A commit whose summary does not match its message decodes but fails
+	// validation.
+	c := codecCommit()
+	c.Summary = "other"
+	data, err := model.MarshalCBOR(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got *git.Commit
+	if err := model.FromCBOR(data, &got); err == nil {
+		t.Error("FromCBOR() of invalid commit succeeded, want error")
+	}
+
+	if err := model.FromCBOR([]byte{0xff}, &got); err == nil {
+		t.Error("FromCBOR() of malformed data succeeded, want error")
+	}
+}
+
+func TestMarshalUnmarshal_MediaTypes(t *testing.T) {
+	want := codecCommit()
+	for _, mt := range model.MediaTypes() {
+		t.Run(string(mt), func(t *testing.T) {
+			data, err := model.Marshal(want, mt)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			var got *git.Commit
+			if err := model.Unmarshal(data, mt, &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !got.Equal(*want) {
+				t.Errorf("Unmarshal() = %+v, want %+v", got, want)
+			}
+		})
+	}
+
+	if _, err := model.Marshal(want, "text/plain"); err == nil {
+		t.Error("Marshal() with unsupported media type succeeded, want error")
+	}
+}
+
+func TestParseMediaType(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    model.MediaType
+		wantErr bool
+	}{
+		{"application/json", model.MediaTypeJSON, false},
+		{"application/json; charset=utf-8", model.MediaTypeJSON, false},
+		{"Application/CBOR", model.MediaTypeCBOR, false},
+		{"application/x-yaml", model.MediaTypeYAML, false},
+		{"text/yaml", model.MediaTypeYAML, false},
+		{"application/toml", model.MediaTypeTOML, false},
+		{"text/plain", "", true},
+		{"", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := model.ParseMediaType(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseMediaType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMediaType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   model.MediaType
+		ok     bool
+	}{
+		{"", model.MediaTypeJSON, true},
+		{"*/*", model.MediaTypeJSON, true},
+		{"application/cbor", model.MediaTypeCBOR, true},
+		{"application/json;q=0.5, application/cbor", model.MediaTypeCBOR, true},
+		{"application/cbor;q=0.1, application/*;q=0.5", model.MediaTypeJSON, true},
+		{"*/*;q=0.1, text/yaml", model.MediaTypeYAML, true},
+		{"application/*, application/cbor", model.MediaTypeJSON, true},
+		{"application/json;q=0, */*", model.MediaTypeYAML, true},
+		{"text/html", "", false},
+		{"application/cbor;q=0", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			got, ok := model.Negotiate(tt.accept)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("Negotiate(%q) = %q, %v, want %q, %v", tt.accept, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
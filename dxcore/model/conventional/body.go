@@ -19,8 +19,10 @@ package conventional
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"strings"
 
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
 	"gopkg.in/yaml.v3"
 )
@@ -243,6 +245,11 @@ func (b Body) Redacted() string {
 	return b.String()
 }
 
+// LogValue implements slog.LogValuer, logging the Body as its Redacted form.
+func (b Body) LogValue() slog.Value {
+	return slog.StringValue(b.Redacted())
+}
+
 // TypeName returns the canonical name of this model type for debugging,
 // logging, and reflection purposes. This method satisfies the model.Identifiable
 // interface's TypeName requirement.
@@ -368,20 +375,34 @@ func (b Body) Validate() error {
 
 	// Check for raw CR characters (line endings must be normalized to LF)
 	if strings.Contains(str, "\r") {
-		return fmt.Errorf("Body contains raw CR characters (line endings must be normalized to LF)")
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeFormat,
+			Type:   b.TypeName(),
+			Reason: "Body contains raw CR characters (line endings must be normalized to LF)",
+		}
 	}
 
 	// Check byte size constraint
 	byteLen := len(str)
 	if byteLen > BodyMaxBytes {
-		return fmt.Errorf("Body is too large: %d bytes (maximum: %d bytes)", byteLen, BodyMaxBytes)
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeTooLong,
+			Type:   b.TypeName(),
+			Reason: fmt.Sprintf("Body is too large: %d bytes (maximum: %d bytes)", byteLen, BodyMaxBytes),
+			Value:  byteLen,
+		}
 	}
 
 	// Count lines (split by '\n')
 	lines := strings.Split(str, "\n")
 	lineCount := len(lines)
 	if lineCount > BodyMaxLines {
-		return fmt.Errorf("Body has too many lines: %d lines (maximum: %d lines)", lineCount, BodyMaxLines)
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeTooLong,
+			Type:   b.TypeName(),
+			Reason: fmt.Sprintf("Body has too many lines: %d lines (maximum: %d lines)", lineCount, BodyMaxLines),
+			Value:  lineCount,
+		}
 	}
 
 	return nil
@@ -0,0 +1,333 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional
+
+import (
+	"fmt"
+	"strings"
+
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+)
+
+// MessageBuilder constructs a Message step by step, validating each component
+// as it is set. It is intended for tools that author commits, such as bots
+// and interactive prompts, where building a Message literal and validating it
+// at the end would report problems far from their cause.
+//
+// Methods return the builder so calls can be chained. The first failing call
+// records its error and turns every later call into a no-op; the error is
+// reported by Err and Build.
+//
+// Example:
+//
+//	msg, err := conventional.NewMessageBuilder().
+//	    WithType(conventional.Feat).
+//	    WithScope("api").
+//	    WithSubject("add search endpoint").
+//	    BreakingChange("the /find endpoint was removed").
+//	    AddTrailer("Refs", "#42").
+//	    Build()
+//
+// A MessageBuilder is not safe for concurrent use.
+type MessageBuilder struct {
+	msg Message
+	err error
+
+	// typeSet records whether WithType was called. The zero Type is Feat,
+	// so the message itself cannot tell a missing type from feat.
+	typeSet bool
+}
+
+// NewMessageBuilder returns an empty MessageBuilder.
+func NewMessageBuilder() *MessageBuilder {
+	return &MessageBuilder{}
+}
+
+// WithType sets the commit type.
+func (b *MessageBuilder) WithType(t Type) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	if err := t.Validate(); err != nil {
+		b.err = dxerrors.Nest(err, b.msg.TypeName(), "Type")
+		return b
+	}
+	b.msg.Type = t
+	b.typeSet = true
+	return b
+}
+
+// WithScope sets a single scope, parsed with ParseScope, replacing any
+// scopes set before. An empty string clears the scope.
+func (b *MessageBuilder) WithScope(scope string) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.msg.Scopes = nil
+	if scope == "" {
+		b.msg.Scope = ""
+		return b
+	}
+	s, err := ParseScope(scope)
+	if err != nil {
+		b.err = dxerrors.Nest(err, b.msg.TypeName(), "Scope")
+		return b
+	}
+	b.msg.Scope = s
+	return b
+}
+
+// WithScopes sets the scopes of a multi-scope header, each parsed with
+// ParseScope. One scope is equivalent to WithScope; none clears the scope.
+// Duplicate scopes are rejected.
+func (b *MessageBuilder) WithScopes(scopes ...string) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	if len(scopes) <= 1 {
+		b.msg.Scopes = nil
+		if len(scopes) == 0 {
+			return b.WithScope("")
+		}
+		return b.WithScope(scopes[0])
+	}
+
+	parsed, err := parseScopes(strings.Join(scopes, DefaultScopeSeparators[:1]), DefaultScopeSeparators)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	if len(parsed) != len(scopes) {
+		b.err = &dxerrors.ValidationError{
+			Code:   dxerrors.CodeFormat,
+			Type:   b.msg.TypeName(),
+			Field:  "Scopes",
+			Reason: fmt.Sprintf("a scope in %q contains one of %q", scopes, DefaultScopeSeparators),
+			Value:  scopes,
+		}
+		return b
+	}
+	b.msg.Scope = parsed[0]
+	b.msg.Scopes = parsed
+	return b
+}
+
+// WithSubjectOptions sets the options the subject is parsed and validated
+// with (see Message.WithSubjectOptions). It MUST be called before
+// WithSubject to take effect on it.
+func (b *MessageBuilder) WithSubjectOptions(opts SubjectOptions) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.msg.subjectOpts = opts
+	return b
+}
+
+// WithSubject sets the subject, parsed with ParseSubjectWith and the options
+// of WithSubjectOptions, which default to those of ParseSubject.
+func (b *MessageBuilder) WithSubject(subject string) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	s, err := ParseSubjectWith(subject, b.msg.subjectOpts)
+	if err != nil {
+		b.err = dxerrors.Nest(err, b.msg.TypeName(), "Subject")
+		return b
+	}
+	b.msg.Subject = s
+	return b
+}
+
+// WithBody sets the body, parsed with ParseBody. An empty string clears the
+// body.
+func (b *MessageBuilder) WithBody(body string) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	if body == "" {
+		b.msg.Body = ""
+		return b
+	}
+	parsed, err := ParseBody(body)
+	if err != nil {
+		b.err = dxerrors.Nest(err, b.msg.TypeName(), "Body")
+		return b
+	}
+	b.msg.Body = parsed
+	return b
+}
+
+// Breaking marks the message as a breaking change with the "!" header
+// marker. Use BreakingChange to also describe the change in a footer.
+func (b *MessageBuilder) Breaking() *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.msg.Breaking = true
+	return b
+}
+
+// BreakingChange marks the message as breaking and sets the BREAKING CHANGE
+// footer to description, replacing any previous BREAKING CHANGE or
+// BREAKING-CHANGE trailer.
+func (b *MessageBuilder) BreakingChange(description string) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	tr := Trailer{Key: BreakingChangeTrailerKey, Value: description}
+	if description == "" {
+		b.err = &dxerrors.ValidationError{
+			Code:   dxerrors.CodeRequired,
+			Type:   b.msg.TypeName(),
+			Field:  b.trailerField("Value"),
+			Reason: "BREAKING CHANGE description cannot be empty",
+		}
+		return b
+	}
+	if err := tr.Validate(); err != nil {
+		b.err = dxerrors.Nest(err, b.msg.TypeName(), b.trailerField(""))
+		return b
+	}
+
+	trailers := make([]Trailer, 0, len(b.msg.Trailers)+1)
+	for _, existing := range b.msg.Trailers {
+		if !isBreakingChangeKey(existing.Key) {
+			trailers = append(trailers, existing)
+		}
+	}
+	b.msg.Trailers = append(trailers, tr)
+	b.msg.Breaking = true
+	return b
+}
+
+// AddTrailer appends a trailer. A BREAKING CHANGE or BREAKING-CHANGE key
+// also marks the message as breaking.
+func (b *MessageBuilder) AddTrailer(key, value string) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	tr := Trailer{Key: key, Value: value}
+	if key == "" {
+		b.err = &dxerrors.ValidationError{
+			Code:   dxerrors.CodeRequired,
+			Type:   b.msg.TypeName(),
+			Field:  b.trailerField("Key"),
+			Reason: "Trailer Key cannot be empty",
+		}
+		return b
+	}
+	if err := tr.Validate(); err != nil {
+		b.err = dxerrors.Nest(err, b.msg.TypeName(), b.trailerField(""))
+		return b
+	}
+	b.msg.Trailers = append(b.msg.Trailers, tr)
+	if isBreakingChangeKey(key) {
+		b.msg.Breaking = true
+	}
+	return b
+}
+
+// CoAuthoredBy appends a Co-authored-by trailer for p.
+func (b *MessageBuilder) CoAuthoredBy(p Person) *MessageBuilder {
+	return b.addPerson(CoAuthoredByTrailerKey, p)
+}
+
+// SignedOffBy appends a Signed-off-by trailer for p.
+func (b *MessageBuilder) SignedOffBy(p Person) *MessageBuilder {
+	return b.addPerson(SignedOffByTrailerKey, p)
+}
+
+// Fixes appends a Fixes trailer listing refs.
+func (b *MessageBuilder) Fixes(refs ...IssueRef) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	if len(refs) == 0 {
+		b.err = &dxerrors.ValidationError{
+			Code:   dxerrors.CodeRequired,
+			Type:   b.msg.TypeName(),
+			Field:  b.trailerField("Value"),
+			Reason: FixesTrailerKey + " trailer needs at least one issue reference",
+		}
+		return b
+	}
+	value := ""
+	for i, ref := range refs {
+		if err := ref.Validate(); err != nil {
+			b.err = dxerrors.Nest(err, b.msg.TypeName(), b.trailerField("Value"))
+			return b
+		}
+		if i > 0 {
+			value += ", "
+		}
+		value += ref.String()
+	}
+	return b.AddTrailer(FixesTrailerKey, value)
+}
+
+// Err returns the first error recorded by the builder, or nil.
+func (b *MessageBuilder) Err() error {
+	return b.err
+}
+
+// Build returns the constructed Message. It returns the first error recorded
+// while building, an error if WithType was never called, or the result of
+// Message.Validate, which fails if the subject was never set.
+func (b *MessageBuilder) Build() (Message, error) {
+	if b.err != nil {
+		return Message{}, b.err
+	}
+	if !b.typeSet {
+		return Message{}, &dxerrors.ValidationError{
+			Code:   dxerrors.CodeRequired,
+			Type:   b.msg.TypeName(),
+			Field:  "Type",
+			Reason: "Message Type is required",
+		}
+	}
+	msg := b.msg
+	msg.Scopes = append([]Scope(nil), b.msg.Scopes...)
+	msg.Trailers = append([]Trailer(nil), b.msg.Trailers...)
+	if err := msg.Validate(); err != nil {
+		return Message{}, err
+	}
+	return msg, nil
+}
+
+// addPerson appends a person trailer after validating p.
+func (b *MessageBuilder) addPerson(key string, p Person) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	if err := p.Validate(); err != nil {
+		b.err = dxerrors.Nest(err, b.msg.TypeName(), b.trailerField("Value"))
+		return b
+	}
+	return b.AddTrailer(key, p.String())
+}
+
+// trailerField returns the path of field of the trailer the builder appends
+// next, for example "Trailers[2].Value".
+func (b *MessageBuilder) trailerField(field string) string {
+	return dxerrors.JoinPath(fmt.Sprintf("Trailers[%d]", len(b.msg.Trailers)), field)
+}
+
+// isBreakingChangeKey reports whether key is either spelling of the breaking
+// change footer.
+func isBreakingChangeKey(key string) bool {
+	return key == BreakingChangeTrailerKey || key == BreakingChangeHyphenTrailerKey
+}
@@ -0,0 +1,118 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional_test
+
+import (
+	"errors"
+	"testing"
+
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+func TestMessageBuilder_Build(t *testing.T) {
+	msg, err := conventional.NewMessageBuilder().
+		WithType(conventional.Feat).
+		WithScope("api").
+		WithSubject("add search endpoint").
+		WithBody("Adds a search endpoint.").
+		BreakingChange("the /find endpoint was removed").
+		Fixes(conventional.IssueRef{Number: 12}, conventional.IssueRef{Owner: "o", Repo: "r", Number: 3}).
+		CoAuthoredBy(conventional.Person{Name: "Jane Doe", Email: "jane@example.com"}).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	want := "feat(api)!: add search endpoint\n\n" +
+		"Adds a search endpoint.\n\n" +
+		"BREAKING CHANGE: the /find endpoint was removed\n" +
+		"Fixes: #12, o/r#3\n" +
+		"Co-authored-by: Jane Doe <jane@example.com>"
+	if got := msg.String(); got != want {
+		t.Errorf("String() =\n%s\nwant\n%s", got, want)
+	}
+
+	parsed, err := conventional.ParseMessage(msg.String())
+	if err != nil {
+		t.Fatalf("ParseMessage(String()) error = %v", err)
+	}
+	if !parsed.Equal(msg) {
+		t.Errorf("round trip = %+v, want %+v", parsed, msg)
+	}
+}
+
+func TestMessageBuilder_BreakingChangeReplaces(t *testing.T) {
+	msg, err := conventional.NewMessageBuilder().
+		WithType(conventional.Fix).
+		WithSubject("repair x").
+		AddTrailer("BREAKING-CHANGE", "first").
+		BreakingChange("second").
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if !msg.Breaking || len(msg.Trailers) != 1 || msg.Trailers[0].Value != "second" {
+		t.Errorf("Breaking = %v, Trailers = %v", msg.Breaking, msg.Trailers)
+	}
+}
+
+func TestMessageBuilder_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *conventional.MessageBuilder
+		path    string
+		code    dxerrors.Code
+	}{
+		{"missing_type", conventional.NewMessageBuilder().WithSubject("x"), "Message.Type", dxerrors.CodeRequired},
+		{"missing_subject", conventional.NewMessageBuilder().WithType(conventional.Fix), "Message.Subject", dxerrors.CodeRequired},
+		{"invalid_type", conventional.NewMessageBuilder().WithType(conventional.Type(200)).WithSubject("x"), "Message.Type", dxerrors.CodeOutOfRange},
+		{"invalid_scope", conventional.NewMessageBuilder().WithType(conventional.Fix).WithScope("Bad Scope").WithSubject("x"), "Message.Scope", dxerrors.CodeFormat},
+		{"duplicate_scope", conventional.NewMessageBuilder().WithType(conventional.Fix).WithScopes("api", "api").WithSubject("x"), "Message.Scopes[1]", dxerrors.CodeDuplicate},
+		{"empty_subject", conventional.NewMessageBuilder().WithType(conventional.Fix).WithSubject(""), "Message.Subject", dxerrors.CodeRequired},
+		{"invalid_trailer", conventional.NewMessageBuilder().WithType(conventional.Fix).WithSubject("x").AddTrailer("bad key", "v"), "Message.Trailers[0].Key", dxerrors.CodeFormat},
+		{"multiline_trailer", conventional.NewMessageBuilder().WithType(conventional.Fix).WithSubject("x").AddTrailer("Refs", "a").AddTrailer("Refs", "a\nb"), "Message.Trailers[1].Value", dxerrors.CodeFormat},
+		{"invalid_person", conventional.NewMessageBuilder().WithType(conventional.Fix).WithSubject("x").SignedOffBy(conventional.Person{Name: "x"}), "Message.Trailers[0].Value.Email", dxerrors.CodeRequired},
+		{"empty_breaking_change", conventional.NewMessageBuilder().WithType(conventional.Fix).WithSubject("x").BreakingChange(""), "Message.Trailers[0].Value", dxerrors.CodeRequired},
+		{"no_issue_refs", conventional.NewMessageBuilder().WithType(conventional.Fix).WithSubject("x").Fixes(), "Message.Trailers[0].Value", dxerrors.CodeRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.builder.Build()
+			var ve *dxerrors.ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("Build() error = %v, want *ValidationError", err)
+			}
+			if ve.Path() != tt.path || ve.Code != tt.code {
+				t.Errorf("Build() error = %s %q, want %s %q", ve.Code, ve.Path(), tt.code, tt.path)
+			}
+		})
+	}
+}
+
+func TestMessageBuilder_FirstErrorSticks(t *testing.T) {
+	b := conventional.NewMessageBuilder().WithScope("Bad Scope")
+	first := b.Err()
+	if first == nil {
+		t.Fatal("Err() = nil after invalid scope")
+	}
+	b.WithType(conventional.Fix).WithSubject("")
+	if b.Err() != first {
+		t.Errorf("Err() = %v, want first error %v", b.Err(), first)
+	}
+}
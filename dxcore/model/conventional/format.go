@@ -0,0 +1,284 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultFormatWidth is the column at which Format wraps body paragraphs by
+// default. 72 columns is the width git's own documentation recommends for
+// commit message bodies.
+const DefaultFormatWidth = 72
+
+// DefaultTrailerOrder lists the trailer keys that Format places first, in
+// this order. Trailers with other keys follow in their original order, and
+// Signed-off-by trailers always come last, as git appends them.
+var DefaultTrailerOrder = []string{
+	BreakingChangeTrailerKey,
+	FixesTrailerKey,
+	ClosesTrailerKey,
+	RefsTrailerKey,
+}
+
+// listItemRegexp matches the marker of a Markdown list item ("- ", "* ",
+// "1. ", "2) ") and captures it without the following space.
+var listItemRegexp = regexp.MustCompile(`^([*-]|[0-9]+[.)])\s+\S`)
+
+// FormatOptions controls Canonicalize and Format.
+type FormatOptions struct {
+	// Width is the column at which body paragraphs are wrapped. Zero means
+	// DefaultFormatWidth; a negative value disables wrapping.
+	Width int
+
+	// TrailerOrder lists the trailer keys, compared case-insensitively, that
+	// are placed first and in this order. Nil means DefaultTrailerOrder.
+	TrailerOrder []string
+
+	// Subject are the options FormatMessage parses and validates the subject
+	// with (see ParseConfig.Subject). Canonicalize and Format ignore them.
+	Subject SubjectOptions
+}
+
+// Canonicalize returns m in canonical form:
+//
+//   - body paragraphs starting with "BREAKING CHANGE:" or "BREAKING-CHANGE:"
+//     are moved into a BREAKING CHANGE footer, and BREAKING-CHANGE trailers
+//     are renamed to BREAKING CHANGE; Breaking is set when either is present
+//   - trailer keys registered with RegisterTrailer take their canonical
+//     spelling ("signed-off-by" becomes "Signed-off-by")
+//   - trailers are stably ordered by opts.TrailerOrder, with Signed-off-by
+//     last
+//   - body prose is re-wrapped at opts.Width; indented lines and fenced code
+//     blocks are kept verbatim, and list items are wrapped with a hanging
+//     indent
+//
+// Canonicalize does not validate its result: wrapping MAY push a long body
+// over BodyMaxLines, which Message.Validate reports.
+func Canonicalize(m Message, opts FormatOptions) Message {
+	width := opts.Width
+	if width == 0 {
+		width = DefaultFormatWidth
+	}
+	order := opts.TrailerOrder
+	if order == nil {
+		order = DefaultTrailerOrder
+	}
+
+	out := m
+	out.Trailers = nil
+
+	body, breaking := extractBreakingParagraphs(m.Body.String())
+	for _, text := range breaking {
+		out.Trailers = append(out.Trailers, Trailer{Key: BreakingChangeTrailerKey, Value: text})
+	}
+	for _, tr := range m.Trailers {
+		out.Trailers = append(out.Trailers, canonicalTrailer(tr))
+	}
+	for _, tr := range out.Trailers {
+		if tr.Key == BreakingChangeTrailerKey {
+			out.Breaking = true
+		}
+	}
+	sortTrailers(out.Trailers, order)
+
+	if width > 0 {
+		body = wrapBody(body, width)
+	}
+	out.Body = Body(strings.TrimSpace(body))
+	return out
+}
+
+// Format renders m in canonical form (see Canonicalize).
+func Format(m Message, opts FormatOptions) string {
+	return Canonicalize(m, opts).String()
+}
+
+// FormatMessage parses raw with DefaultParseConfig and opts.Subject and
+// renders it in canonical form. It returns an error if raw is not a valid
+// Conventional Commit message or if the canonical form does not validate.
+func FormatMessage(raw string, opts FormatOptions) (string, error) {
+	cfg := DefaultParseConfig
+	cfg.Subject = opts.Subject
+	m, err := ParseMessageWithConfig(raw, cfg)
+	if err != nil {
+		return "", err
+	}
+	c := Canonicalize(m, opts)
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+	return c.String(), nil
+}
+
+// canonicalTrailer returns tr with its key in canonical spelling.
+func canonicalTrailer(tr Trailer) Trailer {
+	if strings.EqualFold(tr.Key, BreakingChangeHyphenTrailerKey) || strings.EqualFold(tr.Key, BreakingChangeTrailerKey) {
+		tr.Key = BreakingChangeTrailerKey
+		return tr
+	}
+	if spec, ok := LookupTrailer(tr.Key); ok {
+		tr.Key = spec.Key
+	}
+	return tr
+}
+
+// sortTrailers stably orders trailers by their position in order; keys not
+// in order keep their relative position after those that are, and
+// Signed-off-by trailers go last.
+func sortTrailers(trailers []Trailer, order []string) {
+	rank := func(key string) int {
+		for i, k := range order {
+			if strings.EqualFold(k, key) {
+				return i
+			}
+		}
+		if strings.EqualFold(key, SignedOffByTrailerKey) {
+			return len(order) + 1
+		}
+		return len(order)
+	}
+	sort.SliceStable(trailers, func(i, j int) bool {
+		return rank(trailers[i].Key) < rank(trailers[j].Key)
+	})
+}
+
+// extractBreakingParagraphs removes body paragraphs that start with a
+// breaking change footer and returns the remaining body together with the
+// unfolded text of each removed paragraph. Paragraphs whose text would not
+// fit in a trailer value are left in the body.
+func extractBreakingParagraphs(body string) (string, []string) {
+	if body == "" {
+		return "", nil
+	}
+
+	var (
+		kept     []string
+		breaking []string
+	)
+	for _, para := range strings.Split(body, "\n\n") {
+		trimmed := strings.TrimSpace(para)
+		var rest string
+		switch {
+		case strings.HasPrefix(trimmed, breakingChangePrefix):
+			rest = strings.TrimPrefix(trimmed, breakingChangePrefix)
+		case strings.HasPrefix(trimmed, BreakingChangeHyphenTrailerKey+":"):
+			rest = strings.TrimPrefix(trimmed, BreakingChangeHyphenTrailerKey+":")
+		default:
+			kept = append(kept, para)
+			continue
+		}
+		text := strings.Join(strings.Fields(rest), " ")
+		if (Trailer{Key: BreakingChangeTrailerKey, Value: text}).Validate() != nil {
+			kept = append(kept, para)
+			continue
+		}
+		breaking = append(breaking, text)
+	}
+	return strings.Join(kept, "\n\n"), breaking
+}
+
+// wrapBody re-wraps the prose of body at width. Consecutive blank lines are
+// collapsed into one.
+func wrapBody(body string, width int) string {
+	var (
+		out     []string
+		para    []string
+		inFence bool
+	)
+	flush := func() {
+		if len(para) > 0 {
+			out = append(out, wrapParagraph(para, width)...)
+			para = nil
+		}
+	}
+	blank := func() {
+		if len(out) > 0 && out[len(out)-1] != "" {
+			out = append(out, "")
+		}
+	}
+
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			flush()
+			out = append(out, line)
+			inFence = !inFence
+		case inFence:
+			out = append(out, line)
+		case trimmed == "":
+			flush()
+			blank()
+		case isBlankByte(line[0]):
+			if len(para) > 0 && listItemRegexp.MatchString(para[0]) {
+				// Hanging continuation of a list item.
+				para = append(para, trimmed)
+				continue
+			}
+			flush()
+			out = append(out, line)
+		case listItemRegexp.MatchString(trimmed):
+			flush()
+			para = []string{trimmed}
+		default:
+			para = append(para, trimmed)
+		}
+	}
+	flush()
+
+	return strings.Join(out, "\n")
+}
+
+// wrapParagraph greedily wraps the words of a paragraph at width runes. List
+// items keep their marker on the first line and indent continuation lines to
+// align with the item text. Words longer than width are not broken.
+func wrapParagraph(lines []string, width int) []string {
+	words := strings.Fields(strings.Join(lines, " "))
+	first, indent := "", ""
+	if m := listItemRegexp.FindStringSubmatch(lines[0]); m != nil {
+		first = m[1] + " "
+		indent = strings.Repeat(" ", len(first))
+		words = words[1:]
+	}
+
+	var out []string
+	line := first
+	lineLen := utf8.RuneCountInString(first)
+	empty := true
+	for _, w := range words {
+		wl := utf8.RuneCountInString(w)
+		if !empty && lineLen+1+wl > width {
+			out = append(out, line)
+			line, lineLen, empty = indent, len(indent), true
+		}
+		if !empty {
+			line += " "
+			lineLen++
+		}
+		line += w
+		lineLen += wl
+		empty = false
+	}
+	if !empty || line != "" {
+		out = append(out, line)
+	}
+	return out
+}
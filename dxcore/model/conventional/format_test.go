@@ -0,0 +1,114 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional_test
+
+import (
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+func TestFormatMessage(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		opts  conventional.FormatOptions
+		want  string
+	}{
+		{
+			name:  "wraps_body",
+			input: "fix: repair x\n\nThis is a rather long line of prose that should be wrapped at the configured width.",
+			opts:  conventional.FormatOptions{Width: 30},
+			want: "fix: repair x\n\n" +
+				"This is a rather long line of\n" +
+				"prose that should be wrapped\n" +
+				"at the configured width.",
+		},
+		{
+			name:  "rejoins_short_lines",
+			input: "fix: repair x\n\nshort\nlines\njoined",
+			want:  "fix: repair x\n\nshort lines joined",
+		},
+		{
+			name:  "keeps_code_and_lists",
+			input: "docs: x\n\n- first item that is quite long indeed\n- second\n\n    indented code stays\n\n```\nfenced   code\n```",
+			opts:  conventional.FormatOptions{Width: 20},
+			want: "docs: x\n\n" +
+				"- first item that is\n" +
+				"  quite long indeed\n" +
+				"- second\n\n" +
+				"    indented code stays\n\n" +
+				"```\nfenced   code\n```",
+		},
+		{
+			name:  "no_wrap",
+			input: "fix: x\n\nline one\nline two",
+			opts:  conventional.FormatOptions{Width: -1},
+			want:  "fix: x\n\nline one\nline two",
+		},
+		{
+			name:  "orders_trailers_and_canonical_keys",
+			input: "fix: x\n\nsigned-off-by: A <a@b.c>\nCo-authored-by: B <b@b.c>\nrefs: #2\nFixes: #1\nBREAKING-CHANGE: y",
+			want: "fix!: x\n\n" +
+				"BREAKING CHANGE: y\n" +
+				"Fixes: #1\n" +
+				"Refs: #2\n" +
+				"Co-authored-by: B <b@b.c>\n" +
+				"Signed-off-by: A <a@b.c>",
+		},
+		{
+			name:  "moves_breaking_change_out_of_body",
+			input: "feat: x\n\nIntro.\n\nBREAKING CHANGE: the old\nflag is gone.\n\nMore text.\n\nRefs: #2",
+			want: "feat!: x\n\n" +
+				"Intro.\n\nMore text.\n\n" +
+				"BREAKING CHANGE: the old flag is gone.\n" +
+				"Refs: #2",
+		},
+		{
+			name:  "custom_order",
+			input: "fix: x\n\nFixes: #1\nRefs: #2",
+			opts:  conventional.FormatOptions{TrailerOrder: []string{"refs"}},
+			want:  "fix: x\n\nRefs: #2\nFixes: #1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conventional.FormatMessage(tt.input, tt.opts)
+			if err != nil {
+				t.Fatalf("FormatMessage() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatMessage() =\n%q\nwant\n%q", got, tt.want)
+			}
+
+			again, err := conventional.FormatMessage(got, tt.opts)
+			if err != nil {
+				t.Fatalf("FormatMessage(formatted) error = %v", err)
+			}
+			if again != got {
+				t.Errorf("FormatMessage() is not idempotent:\n%q\n%q", got, again)
+			}
+		})
+	}
+}
+
+func TestFormatMessage_Invalid(t *testing.T) {
+	if _, err := conventional.FormatMessage("not a conventional commit", conventional.FormatOptions{}); err == nil {
+		t.Error("FormatMessage() succeeded, want error")
+	}
+}
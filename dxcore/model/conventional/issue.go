@@ -0,0 +1,284 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// IssueShortRefRegexp matches the short issue reference forms "#123" and
+	// "owner/repo#45". Submatches are owner, repo and number; owner and repo
+	// are empty for the bare "#123" form.
+	IssueShortRefRegexp = regexp.MustCompile(`^(?:([A-Za-z0-9][A-Za-z0-9._-]*)/([A-Za-z0-9._-]+))?#([0-9]+)$`)
+
+	// issueURLPathRegexp matches the path of issue and pull/merge request
+	// URLs on GitHub ("/owner/repo/issues/45", "/owner/repo/pull/45") and
+	// GitLab ("/group/sub/repo/-/issues/45", "/group/repo/-/merge_requests/45").
+	issueURLPathRegexp = regexp.MustCompile(`^/(.+?)/([^/]+)/(?:-/)?(?:issues|pull|pulls|merge_requests)/([0-9]+)/?$`)
+
+	// issueRefSeparatorRegexp splits a trailer value listing several
+	// references, for example "#1, #2 owner/repo#3".
+	issueRefSeparatorRegexp = regexp.MustCompile(`[\s,;]+`)
+)
+
+// IssueRef is a reference to an issue or pull request, as found in the
+// values of Fixes, Closes and Refs trailers.
+//
+// Three forms are recognized:
+//
+//	#123                                     -> {Number: 123}
+//	owner/repo#45                            -> {Owner: "owner", Repo: "repo", Number: 45}
+//	https://github.com/owner/repo/issues/45  -> {Owner: "owner", Repo: "repo", Number: 45, URL: "https://..."}
+//
+// A bare #123 refers to the repository the commit belongs to. URLs whose path
+// does not follow the GitHub or GitLab layout are kept with only URL set.
+//
+// This type implements the model.Model interface. The zero value represents
+// "no reference" and fails validation.
+type IssueRef struct {
+	// Owner is the repository owner (user, organization or GitLab group
+	// path). It is empty for references to the current repository.
+	Owner string `json:"owner,omitempty" yaml:"owner,omitempty"`
+
+	// Repo is the repository name. It is empty for references to the
+	// current repository.
+	Repo string `json:"repo,omitempty" yaml:"repo,omitempty"`
+
+	// Number is the issue or pull request number. It is zero only for URL
+	// references whose number could not be determined.
+	Number int `json:"number,omitempty" yaml:"number,omitempty"`
+
+	// URL is the original URL for references given as links.
+	URL string `json:"url,omitempty" yaml:"url,omitempty"`
+}
+
+// Compile-time check that IssueRef implements model.Model interface.
+var _ model.Model = (*IssueRef)(nil)
+
+// ParseIssueRef parses a single issue reference in one of the forms
+// described on IssueRef. Surrounding whitespace is ignored.
+func ParseIssueRef(s string) (IssueRef, error) {
+	s = strings.TrimSpace(s)
+
+	if m := IssueShortRefRegexp.FindStringSubmatch(s); m != nil {
+		n, err := strconv.Atoi(m[3])
+		if err != nil {
+			return IssueRef{}, &dxerrors.ValidationError{
+				Code:   dxerrors.CodeOutOfRange,
+				Type:   IssueRef{}.TypeName(),
+				Field:  "Number",
+				Reason: fmt.Sprintf("issue reference %q has invalid number", s),
+				Value:  s,
+				Err:    err,
+			}
+		}
+		ref := IssueRef{Owner: m[1], Repo: m[2], Number: n}
+		if err := ref.Validate(); err != nil {
+			return IssueRef{}, err
+		}
+		return ref, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return IssueRef{}, &dxerrors.ValidationError{
+			Code:   dxerrors.CodeFormat,
+			Type:   IssueRef{}.TypeName(),
+			Reason: fmt.Sprintf("issue reference %q must be #N, owner/repo#N or an http(s) URL", s),
+			Value:  s,
+		}
+	}
+
+	ref := IssueRef{URL: s}
+	if m := issueURLPathRegexp.FindStringSubmatch(u.Path); m != nil {
+		if n, err := strconv.Atoi(m[3]); err == nil {
+			ref.Owner, ref.Repo, ref.Number = m[1], m[2], n
+		}
+	}
+	return ref, nil
+}
+
+// ParseIssueRefs parses every reference in a trailer value that lists one or
+// more references separated by commas, semicolons or whitespace, for example
+// "#12, #13 owner/repo#4". Tokens that are not references are skipped, so
+// "Fixes: #12 (partially)" yields #12. The result preserves input order and
+// is nil when no reference is found.
+func ParseIssueRefs(value string) []IssueRef {
+	var refs []IssueRef
+	for _, token := range issueRefSeparatorRegexp.Split(value, -1) {
+		if token == "" {
+			continue
+		}
+		if ref, err := ParseIssueRef(token); err == nil {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// String returns the reference in its most compact form: the URL for link
+// references, "owner/repo#N" for cross-repository references and "#N"
+// otherwise.
+func (r IssueRef) String() string {
+	switch {
+	case r.IsZero():
+		return ""
+	case r.URL != "":
+		return r.URL
+	case r.Owner != "":
+		return r.Owner + "/" + r.Repo + "#" + strconv.Itoa(r.Number)
+	default:
+		return "#" + strconv.Itoa(r.Number)
+	}
+}
+
+// Redacted returns the same value as String; issue references contain no
+// sensitive information.
+func (r IssueRef) Redacted() string {
+	return r.String()
+}
+
+// LogValue implements slog.LogValuer, logging the IssueRef as a group of its
+// non-empty fields.
+func (r IssueRef) LogValue() slog.Value {
+	var attrs []slog.Attr
+	if r.Owner != "" {
+		attrs = append(attrs, slog.String("owner", r.Owner), slog.String("repo", r.Repo))
+	}
+	if r.Number != 0 {
+		attrs = append(attrs, slog.Int("number", r.Number))
+	}
+	if r.URL != "" {
+		attrs = append(attrs, slog.String("url", r.URL))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// TypeName returns "IssueRef".
+func (r IssueRef) TypeName() string {
+	return "IssueRef"
+}
+
+// IsZero reports whether no field is set.
+func (r IssueRef) IsZero() bool {
+	return r.Owner == "" && r.Repo == "" && r.Number == 0 && r.URL == ""
+}
+
+// Equal reports whether r and other have identical fields.
+func (r IssueRef) Equal(other IssueRef) bool {
+	return r == other
+}
+
+// Validate checks that the reference has either a positive Number or a URL,
+// that Number is never negative, and that Owner and Repo are either both set
+// or both empty.
+func (r IssueRef) Validate() error {
+	if r.Number < 0 {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeOutOfRange,
+			Type:   r.TypeName(),
+			Field:  "Number",
+			Reason: fmt.Sprintf("IssueRef Number must not be negative (got %d)", r.Number),
+			Value:  r.Number,
+		}
+	}
+	if r.Number == 0 && r.URL == "" {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeRequired,
+			Type:   r.TypeName(),
+			Reason: "IssueRef must have a Number or a URL",
+		}
+	}
+	if (r.Owner == "") != (r.Repo == "") {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeInconsistent,
+			Type:   r.TypeName(),
+			Field:  "Owner",
+			Reason: fmt.Sprintf("IssueRef Owner and Repo must be set together (got %q and %q)", r.Owner, r.Repo),
+			Value:  r.Owner,
+		}
+	}
+	if strings.ContainsAny(r.Owner+r.Repo+r.URL, " \t\n\r") {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeFormat,
+			Type:   r.TypeName(),
+			Reason: fmt.Sprintf("IssueRef %q contains whitespace (not allowed)", r.String()),
+			Value:  r.String(),
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The reference is validated first
+// and serialized as an object; empty fields are omitted.
+func (r IssueRef) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, fmt.Errorf("cannot marshal invalid %s: %w", r.TypeName(), err)
+	}
+	type issueRef IssueRef
+	return json.Marshal(issueRef(r))
+}
+
+// UnmarshalJSON implements json.Unmarshaler and validates the result.
+func (r *IssueRef) UnmarshalJSON(data []byte) error {
+	type issueRef IssueRef
+	var v issueRef
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("cannot unmarshal JSON: %w", err)
+	}
+	if err := IssueRef(v).Validate(); err != nil {
+		return fmt.Errorf("unmarshaled model is invalid: %w", err)
+	}
+	*r = IssueRef(v)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler with the same semantics as
+// MarshalJSON.
+func (r IssueRef) MarshalYAML() (interface{}, error) {
+	if err := r.Validate(); err != nil {
+		return nil, fmt.Errorf("cannot marshal invalid %s: %w", r.TypeName(), err)
+	}
+	type issueRef IssueRef
+	return issueRef(r), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler with the same semantics as
+// UnmarshalJSON.
+func (r *IssueRef) UnmarshalYAML(node *yaml.Node) error {
+	type issueRef IssueRef
+	var v issueRef
+	if err := node.Decode(&v); err != nil {
+		return fmt.Errorf("cannot unmarshal YAML: %w", err)
+	}
+	if err := IssueRef(v).Validate(); err != nil {
+		return fmt.Errorf("unmarshaled model is invalid: %w", err)
+	}
+	*r = IssueRef(v)
+	return nil
+}
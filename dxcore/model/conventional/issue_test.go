@@ -0,0 +1,117 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+func TestParseIssueRef(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    conventional.IssueRef
+		wantErr bool
+	}{
+		{"#123", conventional.IssueRef{Number: 123}, false},
+		{"owner/repo#45", conventional.IssueRef{Owner: "owner", Repo: "repo", Number: 45}, false},
+		{"https://github.com/owner/repo/issues/45", conventional.IssueRef{Owner: "owner", Repo: "repo", Number: 45, URL: "https://github.com/owner/repo/issues/45"}, false},
+		{"https://github.com/owner/repo/pull/7", conventional.IssueRef{Owner: "owner", Repo: "repo", Number: 7, URL: "https://github.com/owner/repo/pull/7"}, false},
+		{"https://gitlab.com/group/sub/repo/-/issues/9", conventional.IssueRef{Owner: "group/sub", Repo: "repo", Number: 9, URL: "https://gitlab.com/group/sub/repo/-/issues/9"}, false},
+		{"https://tracker.example.com/browse/ABC-1", conventional.IssueRef{URL: "https://tracker.example.com/browse/ABC-1"}, false},
+		{"123", conventional.IssueRef{}, true},
+		{"#", conventional.IssueRef{}, true},
+		{"ftp://example.com/1", conventional.IssueRef{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := conventional.ParseIssueRef(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseIssueRef(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseIssueRef(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseIssueRef_ErrorCode(t *testing.T) {
+	tests := []struct {
+		input string
+		path  string
+		code  dxerrors.Code
+	}{
+		{"123", "IssueRef", dxerrors.CodeFormat},
+		{"#99999999999999999999", "IssueRef.Number", dxerrors.CodeOutOfRange},
+	}
+	for _, tt := range tests {
+		_, err := conventional.ParseIssueRef(tt.input)
+		var ve *dxerrors.ValidationError
+		if !errors.As(err, &ve) {
+			t.Errorf("ParseIssueRef(%q) error = %v, want *ValidationError", tt.input, err)
+			continue
+		}
+		if ve.Path() != tt.path || ve.Code != tt.code {
+			t.Errorf("ParseIssueRef(%q) error = %s %q, want %s %q", tt.input, ve.Code, ve.Path(), tt.code, tt.path)
+		}
+	}
+}
+
+func TestParseIssueRefs(t *testing.T) {
+	got := conventional.ParseIssueRefs("#12, #13 (partially); owner/repo#4")
+	want := []string{"#12", "#13", "owner/repo#4"}
+	if len(got) != len(want) {
+		t.Fatalf("ParseIssueRefs() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Errorf("ref[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	if refs := conventional.ParseIssueRefs("nothing here"); refs != nil {
+		t.Errorf("ParseIssueRefs() = %v, want nil", refs)
+	}
+}
+
+func TestIssueRef_JSONRoundTrip(t *testing.T) {
+	ref := conventional.IssueRef{Owner: "owner", Repo: "repo", Number: 45}
+	data, err := json.Marshal(ref)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(data) != `{"owner":"owner","repo":"repo","number":45}` {
+		t.Errorf("Marshal() = %s", data)
+	}
+	var got conventional.IssueRef
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !got.Equal(ref) {
+		t.Errorf("round trip = %+v, want %+v", got, ref)
+	}
+
+	if err := json.Unmarshal([]byte(`{"owner":"owner","number":1}`), &got); err == nil {
+		t.Error("Unmarshal() accepted Owner without Repo")
+	}
+}
@@ -19,9 +19,12 @@ package conventional
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"regexp"
+	"slices"
 	"strings"
 
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
 	"gopkg.in/yaml.v3"
 )
@@ -165,6 +168,15 @@ type Message struct {
 	// The json/yaml tag "scope,omitempty" omits this field when empty (zero value).
 	Scope Scope `json:"scope,omitempty" yaml:"scope,omitempty"`
 
+	// Scopes lists every scope of a multi-scope header such as
+	// "feat(api,cli): ...", in header order. It is nil for headers with zero
+	// or one scope, so single-scope messages serialize exactly as before.
+	// When set, it MUST contain at least two distinct scopes and Scopes[0]
+	// MUST equal Scope. Use ScopeList to read the scopes of any message.
+	//
+	// The json/yaml tag "scopes,omitempty" omits this field when nil.
+	Scopes []Scope `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+
 	// Subject is a brief, imperative-mood description of the change (e.g., "add user
 	// authentication", "fix memory leak"). This field is REQUIRED and MUST be 1-72
 	// characters long.
@@ -242,11 +254,24 @@ type Message struct {
 	//
 	// The json/yaml tag "trailers,omitempty" omits this field when empty (nil/zero-length slice).
 	Trailers []Trailer `json:"trailers,omitempty" yaml:"trailers,omitempty"`
+
+	// subjectOpts are the options the subject was parsed with (see
+	// ParseConfig.Subject and WithSubjectOptions). Validate measures Subject
+	// with them, so that a message parsed in grapheme or display-width mode
+	// stays valid when it is validated or serialized later.
+	subjectOpts SubjectOptions
 }
 
 // Compile-time assertion that Message implements model.Model.
 var _ model.Model = (*Message)(nil)
 
+// Compile-time assertions that Message implements the optional model
+// interfaces.
+var (
+	_ model.Comparable[Message] = Message{}
+	_ model.Cloneable[Message]  = Message{}
+)
+
 // ParseMessage parses a raw commit message string into a structured Message,
 // extracting and validating all components according to the Conventional Commits
 // specification version 1.0.0.
@@ -305,11 +330,17 @@ var _ model.Model = (*Message)(nil)
 //
 // Trailer Detection Algorithm:
 //
-// ParseMessage uses a backwards-scan algorithm to distinguish body from trailers:
-//  1. Find last non-blank line
-//  2. Scan backwards checking if each line matches trailer format (Key: value)
-//  3. Stop at first non-trailer line or blank line separating trailers from body
-//  4. If ALL non-blank lines are trailers, entire content is trailers (no body)
+// ParseMessage locates the trailer block exactly as git interpret-trailers
+// does with its default configuration (see DefaultTrailerConfig):
+//  1. Only the last paragraph of the message is considered
+//  2. It is a trailer block if ALL of its lines are trailers, or if it
+//     contains a git-generated or configured trailer and at least 25% of its
+//     lines are trailers
+//  3. Lines starting with whitespace continue (fold) the preceding trailer
+//  4. Comment lines are ignored
+//
+// Use ParseMessageWithConfig to configure separators, recognized keys, or the
+// comment character.
 //
 // This approach correctly handles:
 //   - Messages with only trailers (no body)
@@ -327,6 +358,9 @@ var _ model.Model = (*Message)(nil)
 //   - Subject is empty or invalid
 //   - Body exceeds size limits (if present)
 //
+// Every error is a *dxerrors.ValidationError of type "Message" whose Field
+// locates the failing component, for example "Subject" or "Scopes[1]".
+//
 // Note: Invalid trailer lines are silently skipped rather than causing errors,
 // allowing flexibility in trailer formatting while still extracting valid trailers.
 //
@@ -359,9 +393,16 @@ var _ model.Model = (*Message)(nil)
 //	fmt.Println(len(msg.Trailers))    // Output: 1
 //	fmt.Println(msg.Trailers[0].Key)  // Output: "Fixes"
 func ParseMessage(s string) (Message, error) {
+	return ParseMessageWithConfig(s, DefaultParseConfig)
+}
+
+// ParseMessageWithConfig is like ParseMessage but splits multi-scope headers,
+// parses the subject and detects trailers according to cfg (see
+// ParseConfig).
+func ParseMessageWithConfig(s string, cfg ParseConfig) (Message, error) {
 	// Stage 1: Input validation
 	if s == "" {
-		return Message{}, fmt.Errorf("message cannot be empty")
+		return Message{}, errEmptyMessage()
 	}
 
 	// Stage 2: Normalize line endings and split into lines
@@ -369,21 +410,27 @@ func ParseMessage(s string) (Message, error) {
 	normalized = strings.TrimSpace(normalized)
 	lines := strings.Split(normalized, "\n")
 	if len(lines) == 0 {
-		return Message{}, fmt.Errorf("message cannot be empty")
+		return Message{}, errEmptyMessage()
 	}
 
 	// Stage 3: Parse and validate header
-	commitType, scope, breaking, subject, err := parseMessageHeader(lines[0])
+	commitType, scopes, breaking, subject, err := parseMessageHeader(lines[0], cfg)
 	if err != nil {
 		return Message{}, err
 	}
 
 	// Initialize message with header components
 	msg := Message{
-		Type:     commitType,
-		Scope:    scope,
-		Subject:  subject,
-		Breaking: breaking,
+		Type:        commitType,
+		Subject:     subject,
+		Breaking:    breaking,
+		subjectOpts: cfg.Subject,
+	}
+	if len(scopes) > 0 {
+		msg.Scope = scopes[0]
+	}
+	if len(scopes) > 1 {
+		msg.Scopes = scopes
 	}
 
 	// If message is header-only, parsing is complete
@@ -399,19 +446,19 @@ func ParseMessage(s string) (Message, error) {
 	}
 
 	// Stage 5: Find where trailer block starts (using backwards scan)
-	trailerStartIdx := findTrailerStart(lines, contentStartIdx)
+	trailerStartIdx := findTrailerStart(lines, contentStartIdx, cfg.Trailers)
 
 	// Stage 6: Extract body (if exists)
 	body, err := extractBody(lines, contentStartIdx, trailerStartIdx)
 	if err != nil {
-		return Message{}, fmt.Errorf("invalid body: %w", err)
+		return Message{}, dxerrors.Nest(err, msg.TypeName(), "Body")
 	}
 	msg.Body = body
 
 	// Stage 7: Extract trailers and detect breaking changes in footer
-	trailers, hasBreakingChange, err := extractTrailers(lines, trailerStartIdx)
+	trailers, hasBreakingChange, err := extractTrailers(lines, trailerStartIdx, cfg.Trailers)
 	if err != nil {
-		return Message{}, fmt.Errorf("invalid trailers: %w", err)
+		return Message{}, dxerrors.Nest(err, msg.TypeName(), "Trailers")
 	}
 	msg.Trailers = trailers
 
@@ -421,6 +468,12 @@ func ParseMessage(s string) (Message, error) {
 		msg.Breaking = true
 	}
 
+	// Stage 8: Check body and trailers for spoofing characters if asked to
+	// (the subject was checked with the header)
+	if err := msg.checkUnicodeText(); err != nil {
+		return Message{}, err
+	}
+
 	return msg, nil
 }
 
@@ -486,7 +539,7 @@ func (m Message) String() string {
 	// Breaking marker comes after scope (if present) but before colon
 	header := m.Type.String()
 	if !m.Scope.IsZero() {
-		header += "(" + m.Scope.String() + ")"
+		header += "(" + m.scopeHeader(Scope.String) + ")"
 	}
 	if m.Breaking {
 		header += "!"
@@ -557,7 +610,7 @@ func (m Message) Redacted() string {
 	// Build header only: type[(scope)][!]: subject
 	header := m.Type.Redacted()
 	if !m.Scope.IsZero() {
-		header += "(" + m.Scope.Redacted() + ")"
+		header += "(" + m.scopeHeader(Scope.Redacted) + ")"
 	}
 	if m.Breaking {
 		header += "!"
@@ -566,6 +619,29 @@ func (m Message) Redacted() string {
 	return header
 }
 
+// LogValue implements slog.LogValuer, logging the Message as a group of its
+// header fields, the keys of its trailers and whether it has a body. Like
+// Redacted, it omits the body and the trailer values.
+func (m Message) LogValue() slog.Value {
+	attrs := []slog.Attr{slog.Any("type", m.Type)}
+	if !m.Scope.IsZero() {
+		attrs = append(attrs, slog.String("scope", m.scopeHeader(Scope.Redacted)))
+	}
+	attrs = append(attrs,
+		slog.Bool("breaking", m.Breaking),
+		slog.Any("subject", m.Subject),
+		slog.Bool("has_body", !m.Body.IsZero()),
+	)
+	if len(m.Trailers) > 0 {
+		keys := make([]string, len(m.Trailers))
+		for i, tr := range m.Trailers {
+			keys[i] = tr.Key
+		}
+		attrs = append(attrs, slog.Any("trailers", keys))
+	}
+	return slog.GroupValue(attrs...)
+}
+
 // TypeName returns the name of this type for error messages and debugging.
 //
 // This method implements the model.Identifiable contract.
@@ -585,7 +661,8 @@ func (m Message) IsZero() bool {
 // Equal reports whether this Message is equal to another Message.
 //
 // Two Messages are equal if all their fields are equal: Type, Scope, Subject,
-// Breaking flag, Body, and Trailers (in the same order).
+// Breaking flag, Body, and Trailers (in the same order). The SubjectOptions
+// are not compared.
 func (m Message) Equal(other Message) bool {
 	if !m.Type.Equal(other.Type) {
 		return false
@@ -593,6 +670,14 @@ func (m Message) Equal(other Message) bool {
 	if !m.Scope.Equal(other.Scope) {
 		return false
 	}
+	if len(m.Scopes) != len(other.Scopes) {
+		return false
+	}
+	for i := range m.Scopes {
+		if !m.Scopes[i].Equal(other.Scopes[i]) {
+			return false
+		}
+	}
 	if !m.Subject.Equal(other.Subject) {
 		return false
 	}
@@ -613,6 +698,17 @@ func (m Message) Equal(other Message) bool {
 	return true
 }
 
+// Clone returns a deep copy of the Message. The Scopes and Trailers slices
+// are copied, so that modifying the clone never affects the original. Nil
+// slices stay nil.
+//
+// Clone implements model.Cloneable[Message].
+func (m Message) Clone() Message {
+	m.Scopes = slices.Clone(m.Scopes)
+	m.Trailers = slices.Clone(m.Trailers)
+	return m
+}
+
 // Validate checks whether this Message satisfies all Conventional Commits
 // requirements.
 //
@@ -626,44 +722,126 @@ func (m Message) Equal(other Message) bool {
 //   - Body MUST be valid (if present)
 //   - All Trailers MUST be valid (if present)
 func (m Message) Validate() error {
-	// Type is required
-	if m.Type.IsZero() {
-		return fmt.Errorf("Message Type is required")
-	}
-	if err := m.Type.Validate(); err != nil {
-		return fmt.Errorf("invalid Type: %w", err)
-	}
+	return dxerrors.RunChecks(false, m.checks(false)...)
+}
 
-	// Subject is required
-	if m.Subject.IsZero() {
-		return fmt.Errorf("Message Subject is required")
-	}
-	if err := m.Subject.Validate(); err != nil {
-		return fmt.Errorf("invalid Subject: %w", err)
-	}
+// ValidateFull is like Validate, but instead of stopping at the first
+// problem it reports one error per invalid field, joined with errors.Join
+// (see dxerrors.Violations). Nested values are validated fully as well.
+func (m Message) ValidateFull() error {
+	return dxerrors.RunChecks(true, m.checks(true)...)
+}
 
-	// Scope is optional but must be valid if present
-	if !m.Scope.IsZero() {
-		if err := m.Scope.Validate(); err != nil {
-			return fmt.Errorf("invalid Scope: %w", err)
-		}
+// checks returns the validation steps of Validate, one per field. When full
+// is set, nested values and slice elements report every violation.
+func (m Message) checks(full bool) []dxerrors.Check {
+	return []dxerrors.Check{
+		// Type is required
+		func() error {
+			if m.Type.IsZero() {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeRequired,
+					Type:   m.TypeName(),
+					Field:  "Type",
+					Reason: "Message Type is required",
+				}
+			}
+			if err := m.Type.Validate(); err != nil {
+				return dxerrors.Nest(err, m.TypeName(), "Type")
+			}
+			return nil
+		},
+		// Subject is required
+		func() error {
+			if m.Subject.IsZero() {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeRequired,
+					Type:   m.TypeName(),
+					Field:  "Subject",
+					Reason: "Message Subject is required",
+				}
+			}
+			if err := m.Subject.ValidateWith(m.subjectOpts); err != nil {
+				return dxerrors.Nest(err, m.TypeName(), "Subject")
+			}
+			return nil
+		},
+		// Scope is optional but must be valid if present
+		func() error {
+			if !m.Scope.IsZero() {
+				if err := m.Scope.Validate(); err != nil {
+					return dxerrors.Nest(err, m.TypeName(), "Scope")
+				}
+			}
+			return nil
+		},
+		// Scopes is optional but must be consistent with Scope if present
+		func() error {
+			return m.validateScopes(full)
+		},
+		// Body is optional but must be valid if present
+		func() error {
+			if !m.Body.IsZero() {
+				if err := m.Body.Validate(); err != nil {
+					return dxerrors.Nest(err, m.TypeName(), "Body")
+				}
+			}
+			return nil
+		},
+		// All trailers must be valid
+		func() error {
+			return dxerrors.Each(full, len(m.Trailers), func(i int) error {
+				return dxerrors.Nest(dxerrors.ValidateMode(m.Trailers[i], full), m.TypeName(), fmt.Sprintf("Trailers[%d]", i))
+			})
+		},
+		// Body and trailer values must be free of spoofing characters
+		// when the subject options ask for it
+		func() error {
+			return m.checkUnicodeText()
+		},
 	}
+}
 
-	// Body is optional but must be valid if present
-	if !m.Body.IsZero() {
-		if err := m.Body.Validate(); err != nil {
-			return fmt.Errorf("invalid Body: %w", err)
-		}
-	}
+// messageWire is the serialized form of a Message. Its Subject is a plain
+// string, so that the subject is validated by Message.Validate with the
+// SubjectOptions of the message rather than by Subject's own codecs, which
+// use the defaults. Fields MUST be kept in the order of Message.
+type messageWire struct {
+	Type     Type      `json:"type" yaml:"type"`
+	Scope    Scope     `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Scopes   []Scope   `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+	Subject  string    `json:"subject" yaml:"subject"`
+	Breaking bool      `json:"breaking,omitempty" yaml:"breaking,omitempty"`
+	Body     Body      `json:"body,omitempty" yaml:"body,omitempty"`
+	Trailers []Trailer `json:"trailers,omitempty" yaml:"trailers,omitempty"`
+}
 
-	// All trailers must be valid
-	for i, trailer := range m.Trailers {
-		if err := trailer.Validate(); err != nil {
-			return fmt.Errorf("invalid Trailer at index %d: %w", i, err)
-		}
+func (m Message) wire() messageWire {
+	return messageWire{
+		Type:     m.Type,
+		Scope:    m.Scope,
+		Scopes:   m.Scopes,
+		Subject:  string(m.Subject),
+		Breaking: m.Breaking,
+		Body:     m.Body,
+		Trailers: m.Trailers,
 	}
+}
 
-	return nil
+// fromWire sets the fields of m from w, keeping the SubjectOptions of m, and
+// validates the result.
+func (m *Message) fromWire(w messageWire) error {
+	*m = Message{
+		Type:        w.Type,
+		Scope:       w.Scope,
+		Scopes:      w.Scopes,
+		Subject:     Subject(w.Subject),
+		Breaking:    w.Breaking,
+		Body:        w.Body,
+		Trailers:    w.Trailers,
+		subjectOpts: m.subjectOpts,
+	}
+	return m.Validate()
 }
 
 // MarshalJSON serializes this Message to JSON.
@@ -674,25 +852,22 @@ func (m Message) MarshalJSON() ([]byte, error) {
 	if err := m.Validate(); err != nil {
 		return nil, fmt.Errorf("cannot marshal invalid %s: %w", m.TypeName(), err)
 	}
-
-	// Use type alias to avoid infinite recursion
-	type message Message
-	return json.Marshal(message(m))
+	return json.Marshal(m.wire())
 }
 
 // UnmarshalJSON deserializes a Message from JSON.
 //
 // This method implements the json.Unmarshaler interface and the
-// model.Serializable contract.
+// model.Serializable contract. The SubjectOptions of the receiver are kept
+// and used for validation, so a message serialized in grapheme or
+// display-width mode can be decoded into a Message prepared with
+// WithSubjectOptions.
 func (m *Message) UnmarshalJSON(data []byte) error {
-	type message Message
-	var tmp message
-	if err := json.Unmarshal(data, &tmp); err != nil {
+	var w messageWire
+	if err := json.Unmarshal(data, &w); err != nil {
 		return fmt.Errorf("cannot unmarshal JSON into %s: %w", m.TypeName(), err)
 	}
-
-	*m = Message(tmp)
-	return m.Validate()
+	return m.fromWire(w)
 }
 
 // MarshalYAML serializes this Message to YAML.
@@ -703,36 +878,48 @@ func (m Message) MarshalYAML() (interface{}, error) {
 	if err := m.Validate(); err != nil {
 		return nil, fmt.Errorf("cannot marshal invalid %s: %w", m.TypeName(), err)
 	}
-
-	// Use type alias to avoid infinite recursion
-	type message Message
-	return message(m), nil
+	return m.wire(), nil
 }
 
 // UnmarshalYAML deserializes a Message from YAML.
 //
 // This method implements the yaml.Unmarshaler interface and the
-// model.Serializable contract.
+// model.Serializable contract. Like UnmarshalJSON, it keeps the
+// SubjectOptions of the receiver.
 func (m *Message) UnmarshalYAML(node *yaml.Node) error {
-	type message Message
-	var tmp message
-	if err := node.Decode(&tmp); err != nil {
+	var w messageWire
+	if err := node.Decode(&w); err != nil {
 		return fmt.Errorf("cannot unmarshal YAML into %s: %w", m.TypeName(), err)
 	}
+	return m.fromWire(w)
+}
 
-	*m = Message(tmp)
-	return m.Validate()
+// errEmptyMessage returns the error of ParseMessageWithConfig for an empty
+// message.
+func errEmptyMessage() error {
+	return &dxerrors.ValidationError{
+		Code:   dxerrors.CodeRequired,
+		Type:   Message{}.TypeName(),
+		Reason: "message cannot be empty",
+	}
 }
 
 // parseMessageHeader parses and validates the first line of a commit message,
-// extracting type, scope, breaking marker, and subject components.
+// extracting type, scopes, breaking marker, and subject components. Scopes
+// are split on cfg.ScopeSeparators and the subject is parsed according to
+// cfg.Subject (see ParseConfig).
 //
 // Returns the parsed components or an error if the header is invalid.
-func parseMessageHeader(headerLine string) (commitType Type, scope Scope, breaking bool, subject Subject, err error) {
+func parseMessageHeader(headerLine string, cfg ParseConfig) (commitType Type, scopes []Scope, breaking bool, subject Subject, err error) {
 	header := strings.TrimSpace(headerLine)
 	matches := MessageHeaderRegexp.FindStringSubmatch(header)
 	if matches == nil {
-		return Type(0), Scope(""), false, Subject(""), fmt.Errorf("invalid Conventional Commit header format: %q", header)
+		return Type(0), nil, false, Subject(""), &dxerrors.ValidationError{
+			Code:   dxerrors.CodeFormat,
+			Type:   Message{}.TypeName(),
+			Reason: fmt.Sprintf("invalid Conventional Commit header format: %q", header),
+			Value:  header,
+		}
 	}
 
 	// Extract components from regex capture groups
@@ -744,27 +931,27 @@ func parseMessageHeader(headerLine string) (commitType Type, scope Scope, breaki
 	// Parse and validate type
 	commitType, err = ParseType(typeStr)
 	if err != nil {
-		return Type(0), Scope(""), false, Subject(""), fmt.Errorf("invalid type: %w", err)
+		return Type(0), nil, false, Subject(""), dxerrors.Nest(err, Message{}.TypeName(), "Type")
 	}
 
-	// Parse and validate scope if present
+	// Parse and validate scopes if present
 	if scopeStr != "" {
-		scope, err = ParseScope(scopeStr)
+		scopes, err = parseScopes(scopeStr, cfg.ScopeSeparators)
 		if err != nil {
-			return Type(0), Scope(""), false, Subject(""), fmt.Errorf("invalid scope: %w", err)
+			return Type(0), nil, false, Subject(""), err
 		}
 	}
 
 	// Parse and validate subject
-	subject, err = ParseSubject(subjectStr)
+	subject, err = ParseSubjectWith(subjectStr, cfg.Subject)
 	if err != nil {
-		return Type(0), Scope(""), false, Subject(""), fmt.Errorf("invalid subject: %w", err)
+		return Type(0), nil, false, Subject(""), dxerrors.Nest(err, Message{}.TypeName(), "Subject")
 	}
 
 	// Convert breaking marker to boolean
 	breaking = breakingMarker == "!"
 
-	return commitType, scope, breaking, subject, nil
+	return commitType, scopes, breaking, subject, nil
 }
 
 // findContentStart finds the index of the first non-blank line after the header.
@@ -778,88 +965,6 @@ func findContentStart(lines []string) int {
 	return -1
 }
 
-// isTrailerOrBreakingChange checks if a line looks like a trailer or BREAKING CHANGE.
-// This includes:
-//   - Standard git trailer format: "Key: value" where Key matches ^[A-Za-z][A-Za-z0-9-]*$
-//   - BREAKING CHANGE with space: "BREAKING CHANGE: ..."
-//   - BREAKING-CHANGE with hyphen: "BREAKING-CHANGE: ..."
-func isTrailerOrBreakingChange(line string) bool {
-	line = strings.TrimSpace(line)
-	if line == "" {
-		return false
-	}
-
-	// Check for BREAKING CHANGE with space (Conventional Commits canonical format)
-	if strings.HasPrefix(line, "BREAKING CHANGE:") || strings.HasPrefix(line, "BREAKING CHANGE ") {
-		return true
-	}
-
-	// Check for standard git trailer format
-	colonIdx := strings.Index(line, ":")
-	if colonIdx == -1 {
-		return false
-	}
-	key := strings.TrimSpace(line[:colonIdx])
-	return TrailerKeyRegexp.MatchString(key)
-}
-
-// findTrailerStart uses backwards scanning to find where the trailer block starts.
-// Returns -1 if no trailers are found.
-//
-// Algorithm:
-//  1. Find last non-blank line
-//  2. Scan backwards checking if each line is a trailer
-//  3. Stop at first non-trailer line or blank line separating trailers from body
-//  4. If ALL non-blank lines are trailers, entire content is trailers (no body)
-func findTrailerStart(lines []string, contentStartIdx int) int {
-	if contentStartIdx == -1 {
-		return -1
-	}
-
-	// Find last non-blank line
-	lastNonBlankIdx := -1
-	for i := len(lines) - 1; i >= contentStartIdx; i-- {
-		if strings.TrimSpace(lines[i]) != "" {
-			lastNonBlankIdx = i
-			break
-		}
-	}
-
-	if lastNonBlankIdx == -1 {
-		return -1
-	}
-
-	// Scan backwards to find start of trailer block
-	trailerStartIdx := -1
-	inTrailers := true
-
-	for i := lastNonBlankIdx; i >= contentStartIdx; i-- {
-		line := strings.TrimSpace(lines[i])
-
-		if line == "" {
-			// Blank line encountered
-			if inTrailers && trailerStartIdx == -1 {
-				// This blank line separates trailers from body
-				trailerStartIdx = i + 1
-				break
-			}
-			continue
-		}
-
-		if !isTrailerOrBreakingChange(lines[i]) {
-			// Not a trailer line
-			inTrailers = false
-		}
-	}
-
-	// Special case: all non-blank lines are trailers
-	if inTrailers && trailerStartIdx == -1 {
-		trailerStartIdx = contentStartIdx
-	}
-
-	return trailerStartIdx
-}
-
 // extractBody extracts body text from lines between contentStart and trailerStart.
 // Returns empty Body if no body content exists.
 func extractBody(lines []string, contentStartIdx, trailerStartIdx int) (Body, error) {
@@ -901,55 +1006,3 @@ func extractBody(lines []string, contentStartIdx, trailerStartIdx int) (Body, er
 
 	return ParseBody(bodyText)
 }
-
-// extractTrailers extracts and parses all trailer lines from the trailer block.
-// Also detects BREAKING CHANGE/BREAKING-CHANGE and sets the breaking flag.
-//
-// Returns:
-//   - trailers: slice of parsed Trailer objects (includes BREAKING CHANGE as special Trailer)
-//   - hasBreakingChange: true if any BREAKING CHANGE/BREAKING-CHANGE trailer found
-func extractTrailers(lines []string, trailerStartIdx int) ([]Trailer, bool, error) {
-	if trailerStartIdx == -1 {
-		return nil, false, nil
-	}
-
-	var trailers []Trailer
-	hasBreakingChange := false
-
-	for i := trailerStartIdx; i < len(lines); i++ {
-		line := strings.TrimSpace(lines[i])
-		if line == "" {
-			continue
-		}
-
-		// Special handling for BREAKING CHANGE with space
-		// This is not a valid git trailer format, but we still want to capture it
-		if strings.HasPrefix(line, "BREAKING CHANGE:") {
-			hasBreakingChange = true
-			// Extract the value after "BREAKING CHANGE:"
-			value := strings.TrimSpace(strings.TrimPrefix(line, "BREAKING CHANGE:"))
-			// Create a special Trailer with the space preserved in display
-			trailers = append(trailers, Trailer{
-				Key:   "BREAKING CHANGE",
-				Value: value,
-			})
-			continue
-		}
-
-		// Try to parse as standard git trailer
-		trailer, err := ParseTrailer(line)
-		if err != nil {
-			// Skip malformed trailer lines
-			continue
-		}
-
-		trailers = append(trailers, trailer)
-
-		// Check for BREAKING-CHANGE with hyphen
-		if trailer.Key == "BREAKING-CHANGE" {
-			hasBreakingChange = true
-		}
-	}
-
-	return trailers, hasBreakingChange, nil
-}
@@ -17,11 +17,15 @@
 package conventional_test
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 	"testing"
 
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model/conventional"
 	"gopkg.in/yaml.v3"
 )
@@ -473,3 +477,160 @@ func TestMessage_Redacted(t *testing.T) {
 		t.Error("Redacted() contains trailer content")
 	}
 }
+
+func TestMessage_LogValue(t *testing.T) {
+	msg := conventional.Message{
+		Type:     conventional.Feat,
+		Scope:    "api",
+		Subject:  "add endpoint",
+		Breaking: true,
+		Body:     "This is a long body with sensitive information",
+		Trailers: []conventional.Trailer{
+			{Key: "Signed-off-by", Value: "secret@example.com"},
+		},
+	}
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("parsed", "message", msg)
+	var entry struct {
+		Message map[string]any `json:"message"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"type": "feat", "scope": "api", "breaking": true, "subject": "add endpoint",
+		"has_body": true, "trailers": []any{"Signed-off-by"},
+	}
+	if fmt.Sprint(entry.Message) != fmt.Sprint(want) {
+		t.Errorf("LogValue() = %v, want %v", entry.Message, want)
+	}
+	if strings.Contains(buf.String(), "secret") || strings.Contains(buf.String(), "long body") {
+		t.Errorf("LogValue() leaks body or trailer values: %s", buf.String())
+	}
+}
+
+func TestMessage_Validate_FieldPath(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  conventional.Message
+		path string
+		code dxerrors.Code
+	}{
+		{
+			name: "missing subject",
+			msg:  conventional.Message{Type: conventional.Fix},
+			path: "Message.Subject",
+			code: dxerrors.CodeRequired,
+		},
+		{
+			name: "trailer key",
+			msg: conventional.Message{
+				Type:     conventional.Fix,
+				Subject:  "fix bug",
+				Trailers: []conventional.Trailer{{Key: "Refs", Value: "#1"}, {Key: "Bad Key", Value: "x"}},
+			},
+			path: "Message.Trailers[1].Key",
+			code: dxerrors.CodeFormat,
+		},
+		{
+			name: "scope",
+			msg:  conventional.Message{Type: conventional.Fix, Scope: "API", Subject: "fix bug"},
+			path: "Message.Scope",
+			code: dxerrors.CodeFormat,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.Validate()
+			var ve *dxerrors.ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("Validate() = %v, want *ValidationError", err)
+			}
+			if got := ve.Path(); got != tt.path {
+				t.Errorf("Path() = %q, want %q", got, tt.path)
+			}
+			if !errors.Is(err, tt.code) {
+				t.Errorf("Code = %q, want %q", ve.Code, tt.code)
+			}
+		})
+	}
+}
+
+func TestParseMessage_FieldPath(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		path  string
+		code  dxerrors.Code
+	}{
+		{"empty", "", "Message", dxerrors.CodeRequired},
+		{"header", "not a conventional commit", "Message", dxerrors.CodeFormat},
+		{"type", "feature: add x", "Message.Type", dxerrors.CodeOutOfRange},
+		{"scope", "fix(-api): repair x", "Message.Scope", dxerrors.CodeFormat},
+		{"second_scope", "fix(api,-cli): repair x", "Message.Scopes[1]", dxerrors.CodeFormat},
+		{"empty_scope", "fix(api,,cli): repair x", "Message.Scopes[1]", dxerrors.CodeRequired},
+		{"duplicate_scope", "fix(api,api): repair x", "Message.Scopes[1]", dxerrors.CodeDuplicate},
+		{"subject", "fix: " + strings.Repeat("x", conventional.SubjectMaxLen+1), "Message.Subject", dxerrors.CodeTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := conventional.ParseMessage(tt.input)
+			var ve *dxerrors.ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("ParseMessage() = %v, want *ValidationError", err)
+			}
+			if got := ve.Path(); got != tt.path {
+				t.Errorf("Path() = %q, want %q", got, tt.path)
+			}
+			if ve.Code != tt.code {
+				t.Errorf("Code = %q, want %q", ve.Code, tt.code)
+			}
+		})
+	}
+}
+
+func TestMessage_ValidateFull(t *testing.T) {
+	msg := conventional.Message{
+		Type:     conventional.Fix,
+		Scope:    "API",
+		Trailers: []conventional.Trailer{{Key: "Bad Key", Value: "x"}, {Key: "Refs", Value: "a\nb"}},
+	}
+
+	got := dxerrors.Violations(msg.ValidateFull())
+	want := []string{"Message.Subject", "Message.Scope", "Message.Trailers[0].Key", "Message.Trailers[1].Value"}
+	if len(got) != len(want) {
+		t.Fatalf("ValidateFull() reported %d violations, want %d: %v", len(got), len(want), got)
+	}
+	for i, err := range got {
+		var ve *dxerrors.ValidationError
+		if !errors.As(err, &ve) || ve.Path() != want[i] {
+			t.Errorf("violation %d = %v, want path %q", i, err, want[i])
+		}
+	}
+
+	if err := msg.Validate(); len(dxerrors.Violations(err)) != 1 {
+		t.Errorf("Validate() = %v, want a single error", err)
+	}
+}
+
+func TestMessage_Clone(t *testing.T) {
+	orig, err := conventional.ParseMessage("feat(api,cli): add x\n\nRefs: #1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clone := orig.Clone()
+	if !clone.Equal(orig) {
+		t.Fatalf("Clone() = %+v, want %+v", clone, orig)
+	}
+	clone.Scopes[1] = "core"
+	clone.Trailers[0].Value = "#2"
+	if orig.Scopes[1] != "cli" || orig.Trailers[0].Value != "#1" {
+		t.Error("Clone() shares slices with the original")
+	}
+
+	if c := (conventional.Message{}).Clone(); c.Scopes != nil || c.Trailers != nil {
+		t.Errorf("Clone() of nil slices = %+v, want nil slices", c)
+	}
+}
@@ -0,0 +1,201 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+)
+
+// DefaultScopeSeparators are the characters that separate scopes in a
+// multi-scope header such as "feat(api,cli): ..." by default.
+const DefaultScopeSeparators = ","
+
+// ParseConfig configures ParseMessageWithConfig.
+type ParseConfig struct {
+	// Trailers controls trailer block detection (see TrailerConfig).
+	Trailers TrailerConfig
+
+	// ScopeSeparators lists the characters that separate scopes in a
+	// multi-scope header, for example "," for "feat(api,cli): ...".
+	// Whitespace around each scope is ignored, so "fix(core/io, net): ..."
+	// parses as well. Empty disables multi-scope headers: the whole
+	// parenthesized text MUST then be a single scope.
+	ScopeSeparators string
+
+	// Subject controls subject normalization, length measurement and
+	// Unicode checks (see SubjectOptions). The parsed message keeps these
+	// options for Validate. The zero value parses subjects as ParseSubject
+	// does.
+	Subject SubjectOptions
+}
+
+// DefaultParseConfig is the configuration used by ParseMessage.
+var DefaultParseConfig = ParseConfig{
+	Trailers:        DefaultTrailerConfig,
+	ScopeSeparators: DefaultScopeSeparators,
+}
+
+// ScopeList returns every scope of the message in header order: Scopes for
+// multi-scope headers, otherwise Scope alone, or nil for unscoped messages.
+// Callers MUST NOT modify the returned slice.
+func (m Message) ScopeList() []Scope {
+	if len(m.Scopes) > 0 {
+		return m.Scopes
+	}
+	if m.Scope.IsZero() {
+		return nil
+	}
+	return []Scope{m.Scope}
+}
+
+// HasScope reports whether s is one of the message's scopes.
+func (m Message) HasScope(s Scope) bool {
+	for _, sc := range m.ScopeList() {
+		if sc == s {
+			return true
+		}
+	}
+	return false
+}
+
+// scopeHeader renders the scopes for the header, joined by the first default
+// separator, using render for each scope.
+func (m Message) scopeHeader(render func(Scope) string) string {
+	list := m.ScopeList()
+	parts := make([]string, len(list))
+	for i, sc := range list {
+		parts[i] = render(sc)
+	}
+	return strings.Join(parts, DefaultScopeSeparators[:1])
+}
+
+// validateScopes checks the consistency of Scopes with Scope. When full is
+// set, every invalid, empty or duplicate element is reported.
+func (m Message) validateScopes(full bool) error {
+	if len(m.Scopes) == 0 {
+		return nil
+	}
+	if len(m.Scopes) == 1 {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeInconsistent,
+			Type:   m.TypeName(),
+			Field:  "Scopes",
+			Reason: "Message Scopes must list at least two scopes; use Scope for a single scope",
+			Value:  len(m.Scopes),
+		}
+	}
+	if m.Scopes[0] != m.Scope {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeInconsistent,
+			Type:   m.TypeName(),
+			Field:  "Scopes[0]",
+			Reason: fmt.Sprintf("Message Scopes[0] %q must equal Scope %q", m.Scopes[0], m.Scope),
+			Value:  m.Scopes[0],
+		}
+	}
+	seen := make(map[Scope]bool, len(m.Scopes))
+	return dxerrors.Each(full, len(m.Scopes), func(i int) error {
+		sc := m.Scopes[i]
+		if err := sc.Validate(); err != nil {
+			return dxerrors.Nest(err, m.TypeName(), fmt.Sprintf("Scopes[%d]", i))
+		}
+		if sc.IsZero() {
+			return &dxerrors.ValidationError{
+				Code:   dxerrors.CodeRequired,
+				Type:   m.TypeName(),
+				Field:  fmt.Sprintf("Scopes[%d]", i),
+				Reason: fmt.Sprintf("Message Scopes at index %d is empty", i),
+			}
+		}
+		if seen[sc] {
+			return &dxerrors.ValidationError{
+				Code:   dxerrors.CodeDuplicate,
+				Type:   m.TypeName(),
+				Field:  "Scopes",
+				Reason: fmt.Sprintf("Message Scopes contains duplicate scope %q", sc),
+				Value:  sc,
+			}
+		}
+		seen[sc] = true
+		return nil
+	})
+}
+
+// parseScopes splits the parenthesized header text on separators and parses
+// each part with ParseScope. Empty parts, as left by a leading, trailing or
+// doubled separator, and duplicate scopes are rejected. Errors are rooted at
+// Message: at "Scope" for a single scope and at "Scopes[i]" otherwise.
+func parseScopes(s, separators string) ([]Scope, error) {
+	parts := []string{s}
+	if separators != "" {
+		parts = splitAny(s, separators)
+	}
+
+	scopes := make([]Scope, 0, len(parts))
+	seen := make(map[Scope]bool, len(parts))
+	for i, part := range parts {
+		field := "Scope"
+		if len(parts) > 1 {
+			field = fmt.Sprintf("Scopes[%d]", i)
+		}
+		part = strings.TrimSpace(part)
+		if part == "" && len(parts) > 1 {
+			return nil, &dxerrors.ValidationError{
+				Code:   dxerrors.CodeRequired,
+				Type:   Message{}.TypeName(),
+				Field:  field,
+				Reason: fmt.Sprintf("scope list %q has an empty scope at position %d", s, i+1),
+				Value:  s,
+			}
+		}
+		sc, err := ParseScope(part)
+		if err != nil {
+			return nil, dxerrors.Nest(err, Message{}.TypeName(), field)
+		}
+		if seen[sc] {
+			return nil, &dxerrors.ValidationError{
+				Code:   dxerrors.CodeDuplicate,
+				Type:   Message{}.TypeName(),
+				Field:  field,
+				Reason: fmt.Sprintf("duplicate scope %q", sc),
+				Value:  string(sc),
+			}
+		}
+		seen[sc] = true
+		scopes = append(scopes, sc)
+	}
+	return scopes, nil
+}
+
+// splitAny slices s around each occurrence of any of the characters of
+// separators. Unlike strings.FieldsFunc, it keeps the empty parts.
+func splitAny(s, separators string) []string {
+	var parts []string
+	for {
+		i := strings.IndexAny(s, separators)
+		if i < 0 {
+			return append(parts, s)
+		}
+		parts = append(parts, s[:i])
+		_, size := utf8.DecodeRuneInString(s[i:])
+		s = s[i+size:]
+	}
+}
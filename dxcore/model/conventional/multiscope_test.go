@@ -0,0 +1,169 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+func TestParseMessage_MultipleScopes(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    []conventional.Scope
+		header  string
+		wantErr bool
+	}{
+		{"feat: x", nil, "feat: x", false},
+		{"feat(api): x", []conventional.Scope{"api"}, "feat(api): x", false},
+		{"feat(api,cli): x", []conventional.Scope{"api", "cli"}, "feat(api,cli): x", false},
+		{"fix(core/io, net)!: x", []conventional.Scope{"core/io", "net"}, "fix(core/io,net)!: x", false},
+		{"fix(api,api): x", nil, "", true},
+		{"fix(api, b@d): x", nil, "", true},
+		{"fix(,): x", nil, "", true},
+		{"feat(a,): q", nil, "", true},
+		{"feat(,a): q", nil, "", true},
+		{"feat(a,,b): q", nil, "", true},
+		{"feat(a, ,b): q", nil, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			msg, err := conventional.ParseMessage(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			got := msg.ScopeList()
+			if len(got) != len(tt.want) {
+				t.Fatalf("ScopeList() = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("ScopeList()[%d] = %q, want %q", i, got[i], tt.want[i])
+				}
+			}
+			if len(tt.want) > 0 && msg.Scope != tt.want[0] {
+				t.Errorf("Scope = %q, want %q", msg.Scope, tt.want[0])
+			}
+			if len(tt.want) < 2 && msg.Scopes != nil {
+				t.Errorf("Scopes = %v, want nil for single-scope headers", msg.Scopes)
+			}
+			if got := msg.String(); got != tt.header {
+				t.Errorf("String() = %q, want %q", got, tt.header)
+			}
+			if err := msg.Validate(); err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestParseMessageWithConfig_ScopeSeparators(t *testing.T) {
+	cfg := conventional.DefaultParseConfig
+	cfg.ScopeSeparators = ""
+	if _, err := conventional.ParseMessageWithConfig("feat(api,cli): x", cfg); err == nil {
+		t.Error("ParseMessageWithConfig() accepted a multi-scope header with separators disabled")
+	}
+
+	cfg.ScopeSeparators = "+"
+	msg, err := conventional.ParseMessageWithConfig("feat(api+cli): x", cfg)
+	if err != nil {
+		t.Fatalf("ParseMessageWithConfig() error = %v", err)
+	}
+	if !msg.HasScope("api") || !msg.HasScope("cli") || msg.HasScope("net") {
+		t.Errorf("ScopeList() = %v", msg.ScopeList())
+	}
+}
+
+func TestMessage_ScopesJSONCompatibility(t *testing.T) {
+	single, _ := conventional.ParseMessage("feat(api): x")
+	data, err := json.Marshal(single)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if strings.Contains(string(data), "scopes") {
+		t.Errorf("single-scope JSON = %s, want no scopes field", data)
+	}
+
+	multi, _ := conventional.ParseMessage("feat(api,cli): x")
+	data, err = json.Marshal(multi)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"scope":"api","scopes":["api","cli"]`) {
+		t.Errorf("multi-scope JSON = %s", data)
+	}
+	var got conventional.Message
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !got.Equal(multi) {
+		t.Errorf("round trip = %+v, want %+v", got, multi)
+	}
+}
+
+func TestMessage_ValidateScopes(t *testing.T) {
+	base := conventional.Message{Type: conventional.Feat, Subject: "x", Scope: "api"}
+
+	invalid := [][]conventional.Scope{
+		{"api"},
+		{"cli", "api"},
+		{"api", "api"},
+		{"api", ""},
+	}
+	for _, scopes := range invalid {
+		m := base
+		m.Scopes = scopes
+		if err := m.Validate(); err == nil {
+			t.Errorf("Validate() with Scopes %v = nil, want error", scopes)
+		}
+	}
+}
+
+func TestMessageBuilder_WithScopes(t *testing.T) {
+	msg, err := conventional.NewMessageBuilder().
+		WithType(conventional.Fix).
+		WithScopes("core/io", "net").
+		WithSubject("x").
+		Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if got := msg.String(); got != "fix(core/io,net): x" {
+		t.Errorf("String() = %q", got)
+	}
+
+	msg, err = conventional.NewMessageBuilder().WithType(conventional.Fix).WithScopes("api").WithSubject("x").Build()
+	if err != nil || msg.Scopes != nil || msg.Scope != "api" {
+		t.Errorf("WithScopes(api) = %+v, %v", msg, err)
+	}
+
+	if _, err := conventional.NewMessageBuilder().WithType(conventional.Fix).WithScopes("api", "api").WithSubject("x").Build(); err == nil {
+		t.Error("WithScopes() accepted duplicate scopes")
+	}
+	for _, scopes := range [][]string{{"api", ""}, {"api", "cli,"}} {
+		if _, err := conventional.NewMessageBuilder().WithType(conventional.Fix).WithScopes(scopes...).WithSubject("x").Build(); err == nil {
+			t.Errorf("WithScopes(%q) accepted an empty scope", scopes)
+		}
+	}
+}
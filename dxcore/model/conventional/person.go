@@ -0,0 +1,309 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"strings"
+
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// PersonNameMaxLen is the maximum length of a Person name in bytes. It
+	// matches git.SignatureNameMaxLength.
+	PersonNameMaxLen = 256
+
+	// PersonEmailMaxLen is the maximum length of a Person email address in
+	// bytes. It matches git.SignatureEmailMaxLength (RFC 5321).
+	PersonEmailMaxLen = 254
+)
+
+// Person is a name/email identity taken from an attribution trailer such as
+// "Co-authored-by: Jane Doe <jane@example.com>" or
+// "Signed-off-by: John Smith <john@example.com>".
+//
+// Person carries the same identity information as git.Signature but without a
+// timestamp, because trailers do not record when the attributed action took
+// place. Callers that need a git.Signature MAY combine a Person with the
+// commit time.
+//
+// This type implements the model.Model interface. The zero value represents
+// "no person" and fails validation.
+type Person struct {
+	// Name is the human-readable name, for example "Jane Doe". It MUST NOT
+	// be empty.
+	Name string `json:"name" yaml:"name"`
+
+	// Email is the address found between angle brackets, for example
+	// "jane@example.com". It MUST be a valid RFC 5322 address.
+	Email string `json:"email" yaml:"email"`
+}
+
+// Compile-time check that Person implements model.Model interface.
+var _ model.Model = (*Person)(nil)
+
+// Compile-time assertions that Person implements the optional model
+// interfaces.
+var (
+	_ model.Comparable[Person] = Person{}
+	_ model.Cloneable[Person]  = Person{}
+)
+
+// ParsePerson parses an identity in git's "Name <email>" form, as used in
+// attribution trailer values. Surrounding whitespace is ignored.
+//
+// The email is the text between the last pair of angle brackets and the name
+// is everything before it. Names are not required to follow RFC 5322 phrase
+// syntax, so "J. R. Doe <jr@example.com>" is accepted. The result is
+// validated before it is returned. Errors are *dxerrors.ValidationError
+// values of type "Person".
+//
+// Example:
+//
+//	p, err := conventional.ParsePerson("Jane Doe <jane@example.com>")
+//	// p.Name == "Jane Doe", p.Email == "jane@example.com"
+func ParsePerson(s string) (Person, error) {
+	s = strings.TrimSpace(s)
+	open := strings.LastIndex(s, "<")
+	if open == -1 || !strings.HasSuffix(s, ">") || open > len(s)-2 {
+		return Person{}, &dxerrors.ValidationError{
+			Code:   dxerrors.CodeFormat,
+			Type:   Person{}.TypeName(),
+			Reason: fmt.Sprintf("Person %q must have the form \"Name <email>\"", s),
+			Value:  s,
+		}
+	}
+
+	p := Person{
+		Name:  strings.TrimSpace(s[:open]),
+		Email: strings.TrimSpace(s[open+1 : len(s)-1]),
+	}
+	if err := p.Validate(); err != nil {
+		return Person{}, err
+	}
+	return p, nil
+}
+
+// String returns the person in git's "Name <email>" form.
+func (p Person) String() string {
+	if p.IsZero() {
+		return ""
+	}
+	return p.Name + " <" + p.Email + ">"
+}
+
+// Redacted returns the person with the name and email address passed
+// through the current model.RedactionPolicy, for example
+// "Jane Doe <j***@example.com>" with model.DefaultRedaction. The redaction
+// matches git.Signature.Redacted. When the policy drops the name, only the
+// bracketed address remains.
+func (p Person) Redacted() string {
+	if p.IsZero() {
+		return ""
+	}
+	email := "<" + model.RedactEmail(p.Email) + ">"
+	if name := model.RedactName(p.Name); name != "" {
+		return name + " " + email
+	}
+	return email
+}
+
+// LogValue implements slog.LogValuer, logging the person as a group of its
+// name and email, redacted as in Redacted.
+func (p Person) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", model.RedactName(p.Name)),
+		slog.String("email", model.RedactEmail(p.Email)),
+	)
+}
+
+// TypeName returns "Person".
+func (p Person) TypeName() string {
+	return "Person"
+}
+
+// IsZero reports whether both Name and Email are empty.
+func (p Person) IsZero() bool {
+	return p.Name == "" && p.Email == ""
+}
+
+// Equal reports whether p and other have identical Name and Email. Email
+// comparison is exact; callers that want case-insensitive matching SHOULD
+// normalize both values first.
+func (p Person) Equal(other Person) bool {
+	return p.Name == other.Name && p.Email == other.Email
+}
+
+// Clone returns a copy of the Person. Person holds only values, so the copy
+// shares nothing with the original.
+//
+// Clone implements model.Cloneable[Person].
+func (p Person) Clone() Person {
+	return p
+}
+
+// Validate checks that Name is non-empty and at most PersonNameMaxLen bytes,
+// and that Email is a valid RFC 5322 address of at most PersonEmailMaxLen
+// bytes. Neither field may contain angle brackets or newlines.
+func (p Person) Validate() error {
+	return dxerrors.RunChecks(false, p.checks()...)
+}
+
+// ValidateFull is like Validate, but instead of stopping at the first
+// problem it reports one error per invalid field, joined with errors.Join
+// (see dxerrors.Violations).
+func (p Person) ValidateFull() error {
+	return dxerrors.RunChecks(true, p.checks()...)
+}
+
+// checks returns the validation steps of Validate, one per field.
+func (p Person) checks() []dxerrors.Check {
+	return []dxerrors.Check{
+		// Validate Name
+		func() error {
+			if p.Name == "" {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeRequired,
+					Type:   p.TypeName(),
+					Field:  "Name",
+					Reason: "Person Name cannot be empty",
+				}
+			}
+			if len(p.Name) > PersonNameMaxLen {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeTooLong,
+					Type:   p.TypeName(),
+					Field:  "Name",
+					Reason: fmt.Sprintf("Person Name is too long: %d bytes (maximum: %d)", len(p.Name), PersonNameMaxLen),
+					Value:  p.Name,
+				}
+			}
+			if strings.ContainsAny(p.Name, "<>\n\r") {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeFormat,
+					Type:   p.TypeName(),
+					Field:  "Name",
+					Reason: fmt.Sprintf("Person Name %q contains angle brackets or newlines (not allowed)", p.Name),
+					Value:  p.Name,
+				}
+			}
+			return nil
+		},
+		// Validate Email
+		func() error {
+			if p.Email == "" {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeRequired,
+					Type:   p.TypeName(),
+					Field:  "Email",
+					Reason: "Person Email cannot be empty",
+				}
+			}
+			if len(p.Email) > PersonEmailMaxLen {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeTooLong,
+					Type:   p.TypeName(),
+					Field:  "Email",
+					Reason: fmt.Sprintf("Person Email is too long: %d bytes (maximum: %d)", len(p.Email), PersonEmailMaxLen),
+					Value:  p.Email,
+				}
+			}
+			if strings.ContainsAny(p.Email, "<>\n\r") {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeFormat,
+					Type:   p.TypeName(),
+					Field:  "Email",
+					Reason: fmt.Sprintf("Person Email %q contains angle brackets or newlines (not allowed)", p.Email),
+					Value:  p.Email,
+				}
+			}
+			if _, err := mail.ParseAddress(p.Email); err != nil {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeFormat,
+					Type:   p.TypeName(),
+					Field:  "Email",
+					Reason: fmt.Sprintf("Person Email %q has invalid format: %v", p.Email, err),
+					Value:  p.Email,
+					Err:    err,
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// MarshalJSON implements json.Marshaler. The Person is validated first and
+// serialized as an object with "name" and "email" fields.
+func (p Person) MarshalJSON() ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("cannot marshal invalid %s: %w", p.TypeName(), err)
+	}
+	type person Person
+	return json.Marshal(person(p))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both fields are trimmed and the
+// result is validated.
+func (p *Person) UnmarshalJSON(data []byte) error {
+	type person Person
+	var v person
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("cannot unmarshal JSON: %w", err)
+	}
+
+	parsed := Person{Name: strings.TrimSpace(v.Name), Email: strings.TrimSpace(v.Email)}
+	if err := parsed.Validate(); err != nil {
+		return fmt.Errorf("unmarshaled model is invalid: %w", err)
+	}
+
+	*p = parsed
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler with the same semantics as
+// MarshalJSON.
+func (p Person) MarshalYAML() (interface{}, error) {
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("cannot marshal invalid %s: %w", p.TypeName(), err)
+	}
+	type person Person
+	return person(p), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler with the same semantics as
+// UnmarshalJSON.
+func (p *Person) UnmarshalYAML(node *yaml.Node) error {
+	type person Person
+	var v person
+	if err := node.Decode(&v); err != nil {
+		return fmt.Errorf("cannot unmarshal YAML: %w", err)
+	}
+
+	parsed := Person{Name: strings.TrimSpace(v.Name), Email: strings.TrimSpace(v.Email)}
+	if err := parsed.Validate(); err != nil {
+		return fmt.Errorf("unmarshaled model is invalid: %w", err)
+	}
+
+	*p = parsed
+	return nil
+}
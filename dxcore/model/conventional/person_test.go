@@ -0,0 +1,132 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+func TestParsePerson(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    conventional.Person
+		wantErr bool
+	}{
+		{"simple", "Jane Doe <jane@example.com>", conventional.Person{Name: "Jane Doe", Email: "jane@example.com"}, false},
+		{"initials", "  J. R. Doe <jr@example.com> ", conventional.Person{Name: "J. R. Doe", Email: "jr@example.com"}, false},
+		{"github_noreply", "octo <1+octo@users.noreply.github.com>", conventional.Person{Name: "octo", Email: "1+octo@users.noreply.github.com"}, false},
+		{"missing_email", "Jane Doe", conventional.Person{}, true},
+		{"missing_name", "<jane@example.com>", conventional.Person{}, true},
+		{"invalid_email", "Jane <not an email>", conventional.Person{}, true},
+		{"unterminated", "Jane <jane@example.com", conventional.Person{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conventional.ParsePerson(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePerson(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParsePerson(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePerson_ErrorCode(t *testing.T) {
+	tests := []struct {
+		input string
+		path  string
+		code  dxerrors.Code
+	}{
+		{"Jane Doe", "Person", dxerrors.CodeFormat},
+		{"<jane@example.com>", "Person.Name", dxerrors.CodeRequired},
+		{"Jane <not an email>", "Person.Email", dxerrors.CodeFormat},
+	}
+	for _, tt := range tests {
+		_, err := conventional.ParsePerson(tt.input)
+		var ve *dxerrors.ValidationError
+		if !errors.As(err, &ve) {
+			t.Errorf("ParsePerson(%q) error = %v, want *ValidationError", tt.input, err)
+			continue
+		}
+		if ve.Path() != tt.path || ve.Code != tt.code {
+			t.Errorf("ParsePerson(%q) error = %s %q, want %s %q", tt.input, ve.Code, ve.Path(), tt.code, tt.path)
+		}
+	}
+}
+
+func TestPerson_StringAndRedacted(t *testing.T) {
+	p := conventional.Person{Name: "Jane Doe", Email: "jane@example.com"}
+	if got := p.String(); got != "Jane Doe <jane@example.com>" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := p.Redacted(); got != "Jane Doe <j***@example.com>" {
+		t.Errorf("Redacted() = %q", got)
+	}
+	if got := (conventional.Person{}).String(); got != "" {
+		t.Errorf("zero String() = %q, want empty", got)
+	}
+}
+
+func TestPerson_RedactionPolicy(t *testing.T) {
+	p := conventional.Person{Name: "Jane Doe", Email: "jane@example.com"}
+
+	prev := model.SetRedactionPolicy(model.Redaction{Email: model.HashEmail([]byte("salt")), Name: model.DropName})
+	defer model.SetRedactionPolicy(prev)
+
+	got := p.Redacted()
+	if !strings.HasPrefix(got, "<sha256:") || strings.Contains(got, "Jane") || strings.Contains(got, "example") {
+		t.Errorf("Redacted() = %q, want only the hashed address", got)
+	}
+
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("co-author", "person", p)
+	if out := buf.String(); !strings.Contains(out, "person.email=sha256:") || strings.Contains(out, "jane") {
+		t.Errorf("LogValue() logged %s", out)
+	}
+}
+
+func TestPerson_JSONRoundTrip(t *testing.T) {
+	p := conventional.Person{Name: "Jane Doe", Email: "jane@example.com"}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var got conventional.Person
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !got.Equal(p) {
+		t.Errorf("round trip = %+v, want %+v", got, p)
+	}
+
+	if _, err := json.Marshal(conventional.Person{Name: "Jane"}); err == nil {
+		t.Error("Marshal() of invalid Person succeeded")
+	}
+}
@@ -0,0 +1,83 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// ReleaseAsTrailerKey is the trailer key that forces the next version of
+	// the module(s) a commit belongs to, for example "Release-As: 2.0.0".
+	ReleaseAsTrailerKey = "Release-As"
+
+	// SkipReleaseTrailerKey is the trailer key that excludes a commit from
+	// versioning when its value is true ("Skip-Release: true").
+	SkipReleaseTrailerKey = "Skip-Release"
+
+	// DxrelTrailerKey is the generic dxrel directive trailer. The value
+	// "skip" ("dxrel: skip") is equivalent to "Skip-Release: true".
+	DxrelTrailerKey = "dxrel"
+
+	// DxrelSkipDirective is the DxrelTrailerKey value that requests the commit
+	// to be excluded from versioning.
+	DxrelSkipDirective = "skip"
+)
+
+// TrailerValues returns the values of all trailers whose key matches key,
+// in message order. Keys are compared case-insensitively, as git
+// interpret-trailers does. TrailerValues returns nil when no trailer matches.
+func (m Message) TrailerValues(key string) []string {
+	var values []string
+	for _, tr := range m.Trailers {
+		if strings.EqualFold(tr.Key, key) {
+			values = append(values, tr.Value)
+		}
+	}
+	return values
+}
+
+// ReleaseAs returns the version requested by a Release-As trailer and
+// reports whether one is present. When the trailer is repeated, the last
+// occurrence wins. The value is returned verbatim; callers are responsible
+// for parsing it as a semantic version and validating it against the last
+// released version.
+func (m Message) ReleaseAs() (string, bool) {
+	values := m.TrailerValues(ReleaseAsTrailerKey)
+	if len(values) == 0 {
+		return "", false
+	}
+	return values[len(values)-1], true
+}
+
+// SkipRelease reports whether the message asks to be excluded from
+// versioning, either through "Skip-Release: <true>" (any value accepted by
+// strconv.ParseBool) or through "dxrel: skip".
+func (m Message) SkipRelease() bool {
+	for _, v := range m.TrailerValues(SkipReleaseTrailerKey) {
+		if skip, err := strconv.ParseBool(v); err == nil && skip {
+			return true
+		}
+	}
+	for _, v := range m.TrailerValues(DxrelTrailerKey) {
+		if strings.EqualFold(v, DxrelSkipDirective) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional_test
+
+import (
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+func TestMessage_ReleaseAs(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    string
+		want   string
+		wantOK bool
+	}{
+		{"absent", "feat: add x", "", false},
+		{"present", "feat: add x\n\nRelease-As: 2.0.0", "2.0.0", true},
+		{"case_insensitive", "feat: add x\n\nrelease-as: 3.0.0", "3.0.0", true},
+		{"last_wins", "feat: add x\n\nRelease-As: 2.0.0\nRelease-As: 2.1.0", "2.1.0", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := conventional.ParseMessage(tt.raw)
+			if err != nil {
+				t.Fatalf("ParseMessage() error = %v", err)
+			}
+			got, ok := msg.ReleaseAs()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("ReleaseAs() = (%q, %v), want (%q, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMessage_SkipRelease(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want bool
+	}{
+		{"absent", "fix: repair x", false},
+		{"skip_release_true", "fix: repair x\n\nSkip-Release: true", true},
+		{"skip_release_false", "fix: repair x\n\nSkip-Release: false", false},
+		{"skip_release_garbage", "fix: repair x\n\nSkip-Release: maybe", false},
+		{"dxrel_skip", "fix: repair x\n\ndxrel: skip", true},
+		{"dxrel_other", "fix: repair x\n\ndxrel: keep", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := conventional.ParseMessage(tt.raw)
+			if err != nil {
+				t.Fatalf("ParseMessage() error = %v", err)
+			}
+			if got := msg.SkipRelease(); got != tt.want {
+				t.Errorf("SkipRelease() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// RevertsTrailerKey is the trailer key used to reference reverted commits
+	// explicitly, for example "Reverts: 1a2b3c4". Key matching is
+	// case-insensitive, as in git interpret-trailers.
+	RevertsTrailerKey = "Reverts"
+
+	// revertBodyPattern matches the sentence that "git revert" writes into
+	// the body of every revert commit:
+	//
+	//	This reverts commit <hash>.
+	//	This reverts commit <hash>, reversing
+	//	changes made to <hash>.
+	//
+	// Capture group 1 holds the reverted commit hash. Abbreviated hashes of
+	// at least 7 hex digits are accepted so that hand-written messages are
+	// recognized as well.
+	revertBodyPattern = `(?m)^\s*This reverts commit ([0-9a-fA-F]{7,64})\b`
+
+	// revertHashPattern matches a single (possibly abbreviated) commit hash
+	// inside a Reverts trailer value.
+	revertHashPattern = `^[0-9a-fA-F]{7,64}$`
+)
+
+var (
+	// RevertBodyRegexp is the compiled form of revertBodyPattern. It is safe
+	// for concurrent use and SHOULD be treated as read-only.
+	RevertBodyRegexp = regexp.MustCompile(revertBodyPattern)
+
+	revertHashRegexp = regexp.MustCompile(revertHashPattern)
+)
+
+// ParseReverts extracts the hashes of all commits referenced as reverted by
+// a raw commit message.
+//
+// Two forms are recognized: the "This reverts commit <hash>." sentence that
+// git revert generates, and Reverts trailers, read from the trailer block with
+// ParseTrailers and DefaultTrailerConfig. A "Reverts:" line in the title or
+// body is prose, not a trailer, and is ignored. A trailer value MAY list
+// several hashes separated by commas or whitespace. The raw message is
+// scanned rather than a parsed Message because the default git revert header
+// ("Revert \"feat: ...\"") is not a valid Conventional Commit header and would
+// be rejected by ParseMessage.
+//
+// The returned hashes are lowercased and deduplicated, in order of first
+// appearance. They MAY be abbreviated; callers SHOULD match them against full
+// commit hashes by prefix. ParseReverts returns nil when the message
+// references no reverted commit.
+//
+// Example:
+//
+//	hashes := conventional.ParseReverts("Revert \"feat: x\"\n\nThis reverts commit 1a2b3c4d5e6f.")
+//	fmt.Println(hashes) // Output: [1a2b3c4d5e6f]
+func ParseReverts(raw string) []string {
+	normalized := strings.ReplaceAll(raw, "\r\n", "\n")
+
+	var hashes []string
+	seen := make(map[string]bool)
+	add := func(h string) {
+		h = strings.ToLower(h)
+		if !seen[h] {
+			seen[h] = true
+			hashes = append(hashes, h)
+		}
+	}
+
+	for _, m := range RevertBodyRegexp.FindAllStringSubmatch(normalized, -1) {
+		add(m[1])
+	}
+
+	trailers, _ := ParseTrailers(normalized, DefaultTrailerConfig)
+	for _, tr := range trailers {
+		if !strings.EqualFold(tr.Key, RevertsTrailerKey) {
+			continue
+		}
+		for _, field := range strings.FieldsFunc(tr.Value, isRevertSeparator) {
+			if revertHashRegexp.MatchString(field) {
+				add(field)
+			}
+		}
+	}
+
+	return hashes
+}
+
+// Reverts returns the hashes of the commits this Message declares as
+// reverted, using the same rules as ParseReverts applied to the rendered
+// message (body and trailers).
+func (m Message) Reverts() []string {
+	return ParseReverts(m.String())
+}
+
+// isRevertSeparator reports whether r separates hashes in a Reverts trailer
+// value.
+func isRevertSeparator(r rune) bool {
+	return r == ',' || r == ';' || r == ' ' || r == '\t'
+}
@@ -0,0 +1,110 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional_test
+
+import (
+	"reflect"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+func TestParseReverts(t *testing.T) {
+	full := "a1b2c3d4e5f67890abcdef1234567890abcdef12"
+
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "git_revert_body",
+			input: "Revert \"feat: add x\"\n\nThis reverts commit " + full + ".",
+			want:  []string{full},
+		},
+		{
+			name:  "merge_revert_body",
+			input: "revert: undo merge\n\nThis reverts commit " + full + ", reversing\nchanges made to 1111111.",
+			want:  []string{full},
+		},
+		{
+			name:  "reverts_trailer",
+			input: "revert: undo x\n\nReverts: 1a2b3c4",
+			want:  []string{"1a2b3c4"},
+		},
+		{
+			name:  "trailer_case_insensitive_multiple",
+			input: "revert: undo\n\nreverts: ABCDEF1, 1234567",
+			want:  []string{"abcdef1", "1234567"},
+		},
+		{
+			name:  "deduplicated",
+			input: "revert: undo\n\nThis reverts commit abcdef1.\n\nReverts: abcdef1",
+			want:  []string{"abcdef1"},
+		},
+		{
+			name:  "crlf",
+			input: "revert: undo\r\n\r\nThis reverts commit abcdef1.\r\n",
+			want:  []string{"abcdef1"},
+		},
+		{
+			name:  "too_short_ignored",
+			input: "revert: undo\n\nReverts: abc",
+			want:  nil,
+		},
+		{
+			name:  "reverts_line_in_body_ignored",
+			input: "fix: repair x\n\nReverts: 1a2b3c4 was wrong, so this fixes it.\n\nSigned-off-by: Jane <jane@example.com>",
+			want:  nil,
+		},
+		{
+			name:  "reverts_line_in_title_ignored",
+			input: "Reverts: 1a2b3c4",
+			want:  nil,
+		},
+		{
+			name:  "trailer_after_body",
+			input: "revert: undo\n\nReverts: abcdef0 is mentioned here.\n\nReverts: 1234567\nSigned-off-by: Jane <jane@example.com>",
+			want:  []string{"1234567"},
+		},
+		{
+			name:  "no_reference",
+			input: "feat: add x",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := conventional.ParseReverts(tt.input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseReverts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessage_Reverts(t *testing.T) {
+	msg, err := conventional.ParseMessage("revert: undo feature\n\nThis reverts commit abcdef1234.\n\nReverts: 7654321")
+	if err != nil {
+		t.Fatalf("ParseMessage() error = %v", err)
+	}
+	want := []string{"abcdef1234", "7654321"}
+	if got := msg.Reverts(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Reverts() = %v, want %v", got, want)
+	}
+}
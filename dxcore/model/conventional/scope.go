@@ -19,9 +19,11 @@ package conventional
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"regexp"
 	"strings"
 
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
 	"gopkg.in/yaml.v3"
 )
@@ -255,6 +257,11 @@ func (s Scope) Redacted() string {
 	return s.String()
 }
 
+// LogValue implements slog.LogValuer, logging the Scope as its Redacted form.
+func (s Scope) LogValue() slog.Value {
+	return slog.StringValue(s.Redacted())
+}
+
 // TypeName returns the canonical name of this model type for debugging,
 // logging, and reflection purposes. This method satisfies the model.Identifiable
 // interface's TypeName requirement.
@@ -376,20 +383,40 @@ func (s Scope) Validate() error {
 
 	// Check length constraints
 	if len(str) < ScopeMinLen {
-		return fmt.Errorf("Scope %q is too short (minimum length: %d)", str, ScopeMinLen)
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeTooShort,
+			Type:   s.TypeName(),
+			Reason: fmt.Sprintf("Scope %q is too short (minimum length: %d)", str, ScopeMinLen),
+			Value:  str,
+		}
 	}
 	if len(str) > ScopeMaxLen {
-		return fmt.Errorf("Scope %q is too long (maximum length: %d)", str, ScopeMaxLen)
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeTooLong,
+			Type:   s.TypeName(),
+			Reason: fmt.Sprintf("Scope %q is too long (maximum length: %d)", str, ScopeMaxLen),
+			Value:  str,
+		}
 	}
 
 	// Check for whitespace (not allowed)
 	if strings.ContainsAny(str, " \t\n\r") {
-		return fmt.Errorf("Scope %q contains whitespace (not allowed)", str)
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeFormat,
+			Type:   s.TypeName(),
+			Reason: fmt.Sprintf("Scope %q contains whitespace (not allowed)", str),
+			Value:  str,
+		}
 	}
 
 	// Check format against regexp
 	if !ScopeRegexp.MatchString(str) {
-		return fmt.Errorf("Scope %q does not match required format (must be lowercase alphanumeric with optional dots, underscores, slashes, hyphens)", str)
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeFormat,
+			Type:   s.TypeName(),
+			Reason: fmt.Sprintf("Scope %q does not match required format (must be lowercase alphanumeric with optional dots, underscores, slashes, hyphens)", str),
+			Value:  str,
+		}
 	}
 
 	return nil
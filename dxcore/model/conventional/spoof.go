@@ -0,0 +1,47 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional
+
+import (
+	stderrors "errors"
+	"fmt"
+
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model"
+)
+
+// CheckUnicode runs model.CheckText on the rendered message (see String),
+// so that positions refer to the text as it will be displayed. Scopes are
+// covered through the header line.
+func (m Message) CheckUnicode() error {
+	return model.CheckText(m.TypeName(), m.String())
+}
+
+// checkUnicodeText reports the findings of model.CheckText in the body and
+// trailer values when the SubjectOptions of m enable CheckUnicode. The
+// subject is checked by Subject.ValidateWith.
+func (m Message) checkUnicodeText() error {
+	if !m.subjectOpts.CheckUnicode {
+		return nil
+	}
+	errs := []error{dxerrors.Nest(model.CheckText(m.Body.TypeName(), m.Body.String()), m.TypeName(), "Body")}
+	for i, tr := range m.Trailers {
+		err := dxerrors.Nest(model.CheckText(tr.TypeName(), tr.Value), tr.TypeName(), "Value")
+		errs = append(errs, dxerrors.Nest(err, m.TypeName(), fmt.Sprintf("Trailers[%d]", i)))
+	}
+	return stderrors.Join(errs...)
+}
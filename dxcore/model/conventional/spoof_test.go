@@ -0,0 +1,97 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+)
+
+func TestMessage_CheckUnicode(t *testing.T) {
+	m := conventional.Message{Type: conventional.Fix, Subject: "check \u0430dmin"}
+	err := m.CheckUnicode()
+	var pe *dxerrors.ParseError
+	if !errors.As(err, &pe) || pe.Line != 1 || pe.Column != 12 || pe.Type != "Message" {
+		t.Errorf("CheckUnicode() error = %v", err)
+	}
+}
+
+func TestSubjectOptions_CheckUnicode(t *testing.T) {
+	const rlo = "\u202e"
+	checks := conventional.SubjectOptions{CheckUnicode: true}
+	cfg := conventional.DefaultParseConfig
+	cfg.Subject = checks
+
+	tests := []struct {
+		name     string
+		validate func(opts conventional.SubjectOptions) error
+		path     string
+	}{
+		{"subject", func(opts conventional.SubjectOptions) error {
+			return conventional.Subject("add " + rlo + "search").ValidateWith(opts)
+		}, ""},
+		{"message_subject", func(opts conventional.SubjectOptions) error {
+			return conventional.Message{Type: conventional.Fix, Subject: "check " + rlo + "admin"}.WithSubjectOptions(opts).Validate()
+		}, "Message.Subject"},
+		{"message_body", func(opts conventional.SubjectOptions) error {
+			return conventional.Message{Type: conventional.Fix, Subject: "x", Body: "line one\n" + rlo + "line two"}.WithSubjectOptions(opts).Validate()
+		}, "Message.Body"},
+		{"message_trailer", func(opts conventional.SubjectOptions) error {
+			m := conventional.Message{Type: conventional.Fix, Subject: "x", Trailers: []conventional.Trailer{{Key: "Refs", Value: "#1" + rlo}}}
+			return m.WithSubjectOptions(opts).Validate()
+		}, "Message.Trailers[0].Value"},
+		{"parse", func(opts conventional.SubjectOptions) error {
+			cfg := conventional.DefaultParseConfig
+			cfg.Subject = opts
+			_, err := conventional.ParseMessageWithConfig("fix: x\n\nif admin "+rlo+"{ return }", cfg)
+			return err
+		}, ""},
+		{"format", func(opts conventional.SubjectOptions) error {
+			_, err := conventional.FormatMessage("fix: check "+rlo+"admin", conventional.FormatOptions{Subject: opts})
+			return err
+		}, ""},
+		{"builder", func(opts conventional.SubjectOptions) error {
+			_, err := conventional.NewMessageBuilder().WithType(conventional.Fix).WithSubjectOptions(opts).WithSubject("x").WithBody(rlo + "hidden").Build()
+			return err
+		}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.validate(conventional.SubjectOptions{}); err != nil {
+				t.Fatalf("without CheckUnicode: error = %v", err)
+			}
+			err := tt.validate(checks)
+			if err == nil || !strings.Contains(err.Error(), "U+202E") {
+				t.Fatalf("with CheckUnicode: error = %v, want the U+202E finding", err)
+			}
+			var ve *dxerrors.ValidationError
+			if tt.path != "" && (!errors.As(err, &ve) || ve.Path() != tt.path) {
+				t.Errorf("with CheckUnicode: error = %v, want path %s", err, tt.path)
+			}
+		})
+	}
+
+	if _, err := conventional.ParseMessageWithConfig("fix: add search\n\nRefs: #1", cfg); err != nil {
+		t.Errorf("ParseMessageWithConfig() error = %v", err)
+	}
+}
@@ -19,9 +19,11 @@ package conventional
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"strings"
 	"unicode"
 
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
 	"gopkg.in/yaml.v3"
 )
@@ -225,6 +227,11 @@ func (d Subject) Redacted() string {
 	return d.String()
 }
 
+// LogValue implements slog.LogValuer, logging the Subject as its Redacted form.
+func (d Subject) LogValue() slog.Value {
+	return slog.StringValue(d.Redacted())
+}
+
 // TypeName returns the canonical name of this model type for debugging,
 // logging, and reflection purposes. This method satisfies the model.Identifiable
 // interface's TypeName requirement.
@@ -346,22 +353,48 @@ func (d Subject) Validate() error {
 		return nil
 	}
 
-	str := string(d)
-
-	// Check for newlines (not allowed in single-line descriptions)
-	if strings.ContainsAny(str, "\n\r") {
-		return fmt.Errorf("Subject %q contains newline characters (not allowed in single-line descriptions)", str)
+	if err := d.checkShape(); err != nil {
+		return err
 	}
+	str := string(d)
 
 	// Count runes (Unicode code points) for length validation
 	runeCount := len([]rune(str))
 
 	// Check length constraints
 	if runeCount < SubjectMinLen {
-		return fmt.Errorf("Subject is too short: %d runes (minimum: %d)", runeCount, SubjectMinLen)
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeTooShort,
+			Type:   d.TypeName(),
+			Reason: fmt.Sprintf("Subject is too short: %d runes (minimum: %d)", runeCount, SubjectMinLen),
+			Value:  str,
+		}
 	}
 	if runeCount > SubjectMaxLen {
-		return fmt.Errorf("Subject is too long: %d runes (maximum: %d)", runeCount, SubjectMaxLen)
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeTooLong,
+			Type:   d.TypeName(),
+			Reason: fmt.Sprintf("Subject is too long: %d runes (maximum: %d)", runeCount, SubjectMaxLen),
+			Value:  str,
+		}
+	}
+
+	return nil
+}
+
+// checkShape reports the violations of Validate that do not depend on the
+// length of the subject: newlines and whitespace-only content.
+func (d Subject) checkShape() error {
+	str := string(d)
+
+	// Check for newlines (not allowed in single-line descriptions)
+	if strings.ContainsAny(str, "\n\r") {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeFormat,
+			Type:   d.TypeName(),
+			Reason: fmt.Sprintf("Subject %q contains newline characters (not allowed in single-line descriptions)", str),
+			Value:  str,
+		}
 	}
 
 	// Check that description contains at least one non-whitespace character
@@ -373,7 +406,12 @@ func (d Subject) Validate() error {
 		}
 	}
 	if !hasNonWhitespace {
-		return fmt.Errorf("Subject %q contains only whitespace (must have meaningful content)", str)
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeFormat,
+			Type:   d.TypeName(),
+			Reason: fmt.Sprintf("Subject %q contains only whitespace (must have meaningful content)", str),
+			Value:  str,
+		}
 	}
 
 	return nil
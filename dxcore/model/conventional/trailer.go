@@ -19,9 +19,11 @@ package conventional
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"regexp"
 	"strings"
 
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
 	"gopkg.in/yaml.v3"
 )
@@ -312,6 +314,12 @@ func (tr Trailer) Redacted() string {
 	return tr.Key
 }
 
+// LogValue implements slog.LogValuer, logging the Trailer as a group holding
+// its key. Like Redacted, it omits the value, which often names people.
+func (tr Trailer) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("key", tr.Key))
+}
+
 // TypeName returns the canonical name of this model type for debugging,
 // logging, and reflection purposes. This method satisfies the model.Identifiable
 // interface's TypeName requirement.
@@ -389,6 +397,14 @@ func (tr Trailer) Equal(other Trailer) bool {
 	return tr.Key == other.Key && tr.Value == other.Value
 }
 
+// Clone returns a copy of the Trailer. Trailer holds only values, so the
+// copy shares nothing with the original.
+//
+// Clone implements model.Cloneable[Trailer].
+func (tr Trailer) Clone() Trailer {
+	return tr
+}
+
 // Validate checks that the Trailer value conforms to all constraints defined
 // by git interpret-trailers conventions and dxrel policies. This method
 // satisfies the model.Validatable interface's Validate requirement, enforcing
@@ -399,7 +415,8 @@ func (tr Trailer) Equal(other Trailer) bool {
 // that satisfies all of the following requirements: the Key MUST be non-empty
 // after trimming; the Key length MUST be between TrailerKeyMinLen and
 // TrailerKeyMaxLen inclusive; the Key MUST match TrailerKeyRegexp (ASCII
-// letters, digits, hyphens, starting with letter); the Key MUST NOT contain
+// letters, digits, hyphens, starting with letter) unless it is exactly
+// BreakingChangeTrailerKey; the Key MUST NOT contain
 // colons; the Value length (if non-empty) MUST NOT exceed TrailerValueMaxLen;
 // the Value MUST NOT contain newline characters (either LF or CRLF).
 //
@@ -424,43 +441,103 @@ func (tr Trailer) Equal(other Trailer) bool {
 //	    log.Error("invalid trailer", "error", err)
 //	}
 func (tr Trailer) Validate() error {
+	return dxerrors.RunChecks(false, tr.checks()...)
+}
+
+// ValidateFull is like Validate, but instead of stopping at the first
+// problem it reports one error per invalid field, joined with errors.Join
+// (see dxerrors.Violations).
+func (tr Trailer) ValidateFull() error {
+	return dxerrors.RunChecks(true, tr.checks()...)
+}
+
+// checks returns the validation steps of Validate, one per field.
+func (tr Trailer) checks() []dxerrors.Check {
 	// Empty trailer is valid (represents "not set")
 	if tr.IsZero() {
 		return nil
 	}
 
-	// Validate Key
-	if tr.Key == "" {
-		return fmt.Errorf("Trailer Key cannot be empty")
-	}
-
-	keyLen := len([]rune(tr.Key))
-	if keyLen < TrailerKeyMinLen {
-		return fmt.Errorf("Trailer Key %q is too short (minimum length: %d)", tr.Key, TrailerKeyMinLen)
-	}
-	if keyLen > TrailerKeyMaxLen {
-		return fmt.Errorf("Trailer Key %q is too long (maximum length: %d)", tr.Key, TrailerKeyMaxLen)
+	return []dxerrors.Check{
+		// Validate Key
+		func() error {
+			if tr.Key == "" {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeRequired,
+					Type:   tr.TypeName(),
+					Field:  "Key",
+					Reason: "Trailer Key cannot be empty",
+				}
+			}
+
+			keyLen := len([]rune(tr.Key))
+			if keyLen < TrailerKeyMinLen {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeTooShort,
+					Type:   tr.TypeName(),
+					Field:  "Key",
+					Reason: fmt.Sprintf("Trailer Key %q is too short (minimum length: %d)", tr.Key, TrailerKeyMinLen),
+					Value:  tr.Key,
+				}
+			}
+			if keyLen > TrailerKeyMaxLen {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeTooLong,
+					Type:   tr.TypeName(),
+					Field:  "Key",
+					Reason: fmt.Sprintf("Trailer Key %q is too long (maximum length: %d)", tr.Key, TrailerKeyMaxLen),
+					Value:  tr.Key,
+				}
+			}
+
+			if strings.Contains(tr.Key, ":") {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeFormat,
+					Type:   tr.TypeName(),
+					Field:  "Key",
+					Reason: fmt.Sprintf("Trailer Key %q contains colon (not allowed)", tr.Key),
+					Value:  tr.Key,
+				}
+			}
+
+			// "BREAKING CHANGE" is the one key allowed to contain a space; ParseMessage
+			// produces it for the Conventional Commits breaking change footer.
+			if tr.Key != BreakingChangeTrailerKey && !TrailerKeyRegexp.MatchString(tr.Key) {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeFormat,
+					Type:   tr.TypeName(),
+					Field:  "Key",
+					Reason: fmt.Sprintf("Trailer Key %q does not match required format (must start with letter, contain only letters, digits, and hyphens)", tr.Key),
+					Value:  tr.Key,
+				}
+			}
+			return nil
+		},
+		// Validate Value (may be empty, but if present must meet constraints)
+		func() error {
+			if strings.ContainsAny(tr.Value, "\n\r") {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeFormat,
+					Type:   tr.TypeName(),
+					Field:  "Value",
+					Reason: fmt.Sprintf("Trailer Value %q contains newline characters (not allowed)", tr.Value),
+					Value:  tr.Value,
+				}
+			}
+
+			valueLen := len([]rune(tr.Value))
+			if valueLen > TrailerValueMaxLen {
+				return &dxerrors.ValidationError{
+					Code:   dxerrors.CodeTooLong,
+					Type:   tr.TypeName(),
+					Field:  "Value",
+					Reason: fmt.Sprintf("Trailer Value is too long: %d runes (maximum: %d)", valueLen, TrailerValueMaxLen),
+					Value:  tr.Value,
+				}
+			}
+			return nil
+		},
 	}
-
-	if strings.Contains(tr.Key, ":") {
-		return fmt.Errorf("Trailer Key %q contains colon (not allowed)", tr.Key)
-	}
-
-	if !TrailerKeyRegexp.MatchString(tr.Key) {
-		return fmt.Errorf("Trailer Key %q does not match required format (must start with letter, contain only letters, digits, and hyphens)", tr.Key)
-	}
-
-	// Validate Value (may be empty, but if present must meet constraints)
-	if strings.ContainsAny(tr.Value, "\n\r") {
-		return fmt.Errorf("Trailer Value %q contains newline characters (not allowed)", tr.Value)
-	}
-
-	valueLen := len([]rune(tr.Value))
-	if valueLen > TrailerValueMaxLen {
-		return fmt.Errorf("Trailer Value is too long: %d runes (maximum: %d)", valueLen, TrailerValueMaxLen)
-	}
-
-	return nil
 }
 
 // MarshalJSON implements json.Marshaler, serializing the Trailer to a JSON
@@ -615,3 +692,10 @@ func (tr *Trailer) UnmarshalYAML(node *yaml.Node) error {
 
 // Compile-time verification that Trailer implements model.Model interface.
 var _ model.Model = (*Trailer)(nil)
+
+// Compile-time assertions that Trailer implements the optional model
+// interfaces.
+var (
+	_ model.Comparable[Trailer] = Trailer{}
+	_ model.Cloneable[Trailer]  = Trailer{}
+)
@@ -0,0 +1,308 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional
+
+import (
+	"strings"
+)
+
+// GitGeneratedTrailerPrefixes lists the line prefixes that git itself
+// writes into commit messages. A paragraph containing one of them is a
+// trailer block as soon as 25% of its lines are trailers, which is how git
+// keeps "Signed-off-by:" lines recognizable below free-form notes.
+var GitGeneratedTrailerPrefixes = []string{
+	"Signed-off-by: ",
+	"(cherry picked from commit ",
+}
+
+// TrailerConfig controls trailer block detection and splitting. It mirrors
+// the subset of git interpret-trailers configuration that affects parsing.
+//
+// The zero value behaves like DefaultTrailerConfig except that comment lines
+// are not recognized.
+type TrailerConfig struct {
+	// Separators lists the characters that separate a trailer key from its
+	// value, like git's trailer.separators. Empty means ":".
+	Separators string
+
+	// Keys lists configured trailer keys, like git's trailer.<token>.key.
+	// A paragraph containing one of them is treated like a paragraph
+	// containing a git-generated trailer. Keys match case-insensitively.
+	Keys []string
+
+	// CommentChar is the comment character, like git's core.commentChar.
+	// Lines starting with it are ignored. Zero disables comment handling.
+	CommentChar byte
+}
+
+// DefaultTrailerConfig matches git interpret-trailers with no trailer
+// configuration: ":" separates keys from values, no keys are configured, and
+// "#" starts a comment.
+var DefaultTrailerConfig = TrailerConfig{
+	Separators:  ":",
+	CommentChar: '#',
+}
+
+// breakingChangePrefix is the Conventional Commits breaking change footer.
+// git does not accept it as a trailer because the key contains a space, so
+// it is matched separately and counts as a trailer line.
+const breakingChangePrefix = BreakingChangeTrailerKey + ":"
+
+// ParseTrailers returns the trailers of the commit message raw, found and
+// unfolded as ParseMessageWithConfig does, which follows
+// "git interpret-trailers --only-trailers --unfold". Unlike ParseMessage, raw
+// need not be a Conventional Commit: as in git, its first paragraph is the
+// title and the trailer block is looked for in the paragraphs after it.
+func ParseTrailers(raw string, cfg TrailerConfig) ([]Trailer, error) {
+	lines := strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n")
+	contentStartIdx := 0
+	for contentStartIdx < len(lines) && (cfg.isComment(lines[contentStartIdx]) || strings.TrimSpace(lines[contentStartIdx]) != "") {
+		contentStartIdx++
+	}
+	if contentStartIdx == len(lines) {
+		return nil, nil
+	}
+	trailers, _, err := extractTrailers(lines, findTrailerStart(lines, contentStartIdx, cfg), cfg)
+	return trailers, err
+}
+
+// findTrailerStart returns the index of the first line of the trailer block
+// within lines[contentStartIdx:], or -1 if the message has no trailer block.
+//
+// The algorithm follows git's find_trailer_block_start. The last paragraph is
+// scanned backwards counting trailer lines, non-trailer lines and possible
+// continuation lines (lines starting with whitespace). Continuation lines
+// that turn out to follow a trailer are folded into it; those that do not are
+// counted as non-trailer lines. At the paragraph boundary, the paragraph is a
+// trailer block if it contains a recognized trailer (see
+// GitGeneratedTrailerPrefixes and TrailerConfig.Keys) and trailers make up at
+// least 25% of its lines, or if every line is a trailer.
+func findTrailerStart(lines []string, contentStartIdx int, cfg TrailerConfig) int {
+	if contentStartIdx == -1 {
+		return -1
+	}
+
+	var (
+		trailerLines      int
+		nonTrailerLines   int
+		continuationLines int
+		recognized        bool
+		onlySpaces        = true
+	)
+
+	decide := func(start int) int {
+		nonTrailerLines += continuationLines
+		if recognized && trailerLines*3 >= nonTrailerLines {
+			return start
+		}
+		if trailerLines > 0 && nonTrailerLines == 0 {
+			return start
+		}
+		return -1
+	}
+
+	for i := len(lines) - 1; i >= contentStartIdx; i-- {
+		line := lines[i]
+
+		if cfg.isComment(line) {
+			nonTrailerLines += continuationLines
+			continuationLines = 0
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			if onlySpaces {
+				continue
+			}
+			return decide(i + 1)
+		}
+		onlySpaces = false
+
+		if hasGitGeneratedPrefix(line) {
+			trailerLines++
+			continuationLines = 0
+			recognized = true
+			continue
+		}
+		if strings.HasPrefix(line, breakingChangePrefix) {
+			trailerLines++
+			continuationLines = 0
+			continue
+		}
+
+		sep := findSeparator(line, cfg.separators())
+		switch {
+		case sep >= 1 && !isBlankByte(line[0]):
+			trailerLines++
+			continuationLines = 0
+			if !recognized && cfg.isConfiguredKey(strings.TrimSpace(line[:sep])) {
+				recognized = true
+			}
+		case isBlankByte(line[0]):
+			continuationLines++
+		default:
+			nonTrailerLines += 1 + continuationLines
+			continuationLines = 0
+		}
+	}
+
+	return decide(contentStartIdx)
+}
+
+// extractTrailers parses the lines of the trailer block starting at
+// trailerStartIdx. It also reports whether a BREAKING CHANGE or
+// BREAKING-CHANGE trailer was found.
+//
+// Continuation lines are appended to the preceding trailer's value, joined by
+// a single space as "git interpret-trailers --unfold" does. Comment lines,
+// lines without a separator (such as "(cherry picked from commit ...)") and
+// trailers that fail validation are skipped, matching the output of
+// "git interpret-trailers --only-trailers".
+func extractTrailers(lines []string, trailerStartIdx int, cfg TrailerConfig) ([]Trailer, bool, error) {
+	if trailerStartIdx == -1 {
+		return nil, false, nil
+	}
+
+	var trailers []Trailer
+	hasBreakingChange := false
+
+	// folding reports whether the previous line produced a trailer that a
+	// continuation line may extend.
+	folding := false
+
+	for i := trailerStartIdx; i < len(lines); i++ {
+		raw := lines[i]
+		line := strings.TrimSpace(raw)
+		if line == "" || cfg.isComment(raw) {
+			folding = false
+			continue
+		}
+
+		if isBlankByte(raw[0]) {
+			if folding {
+				last := &trailers[len(trailers)-1]
+				if last.Value == "" {
+					last.Value = line
+				} else {
+					last.Value += " " + line
+				}
+			}
+			continue
+		}
+
+		// Special handling for BREAKING CHANGE with space
+		// This is not a valid git trailer format, but we still want to capture it
+		if strings.HasPrefix(line, breakingChangePrefix) {
+			hasBreakingChange = true
+			trailers = append(trailers, Trailer{
+				Key:   BreakingChangeTrailerKey,
+				Value: strings.TrimSpace(strings.TrimPrefix(line, breakingChangePrefix)),
+			})
+			folding = true
+			continue
+		}
+
+		sep := findSeparator(raw, cfg.separators())
+		if sep < 1 {
+			folding = false
+			continue
+		}
+		trailer := Trailer{
+			Key:   strings.TrimSpace(raw[:sep]),
+			Value: strings.TrimSpace(raw[sep+1:]),
+		}
+		if err := trailer.Validate(); err != nil {
+			// Skip malformed trailer lines
+			folding = false
+			continue
+		}
+
+		trailers = append(trailers, trailer)
+		folding = true
+
+		// Check for BREAKING-CHANGE with hyphen
+		if trailer.Key == BreakingChangeHyphenTrailerKey {
+			hasBreakingChange = true
+		}
+	}
+
+	return trailers, hasBreakingChange, nil
+}
+
+// findSeparator returns the byte offset of the first separator in line, or
+// -1 if line does not start with a trailer key. Like git's find_separator,
+// the key consists of ASCII letters, digits and hyphens and MAY be followed
+// by blanks before the separator ("Key : value").
+func findSeparator(line, separators string) int {
+	whitespace := false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		if strings.IndexByte(separators, c) >= 0 {
+			return i
+		}
+		if !whitespace && (isAlnumByte(c) || c == '-') {
+			continue
+		}
+		if i > 0 && isBlankByte(c) {
+			whitespace = true
+			continue
+		}
+		break
+	}
+	return -1
+}
+
+// hasGitGeneratedPrefix reports whether line starts with one of
+// GitGeneratedTrailerPrefixes.
+func hasGitGeneratedPrefix(line string) bool {
+	for _, prefix := range GitGeneratedTrailerPrefixes {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// separators returns the configured separators, defaulting to ":".
+func (cfg TrailerConfig) separators() string {
+	if cfg.Separators == "" {
+		return ":"
+	}
+	return cfg.Separators
+}
+
+// isComment reports whether line is a comment line.
+func (cfg TrailerConfig) isComment(line string) bool {
+	return cfg.CommentChar != 0 && line != "" && line[0] == cfg.CommentChar
+}
+
+// isConfiguredKey reports whether key matches one of cfg.Keys.
+func (cfg TrailerConfig) isConfiguredKey(key string) bool {
+	for _, k := range cfg.Keys {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func isBlankByte(c byte) bool {
+	return c == ' ' || c == '\t'
+}
+
+func isAlnumByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,220 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional_test
+
+import (
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+// trailerCorpus is a conformance corpus for trailer block detection. Each
+// want list is the output of
+//
+//	git [-c <config>] interpret-trailers --only-trailers --only-input --unfold
+//
+// for the given message, as produced by git 2.39.
+var trailerCorpus = []struct {
+	name string
+	cfg  *conventional.TrailerConfig
+	msg  string
+	want []string
+}{
+	{
+		name: "all_trailers",
+		msg:  "fix: x\n\nFixes: #1\nRefs: #2",
+		want: []string{"Fixes: #1", "Refs: #2"},
+	},
+	{
+		name: "body_then_trailers",
+		msg:  "fix: x\n\nSome body text.\n\nFixes: #1\nReviewed-by: A <a@b.c>",
+		want: []string{"Fixes: #1", "Reviewed-by: A <a@b.c>"},
+	},
+	{
+		name: "trailing_blank_lines",
+		msg:  "fix: x\n\nFixes: #1\n\n\n",
+		want: []string{"Fixes: #1"},
+	},
+	{
+		name: "mixed_without_recognized_trailer",
+		msg:  "fix: x\n\nFixes: #1\nnot a trailer line",
+		want: nil,
+	},
+	{
+		name: "single_paragraph_ending_in_trailer",
+		msg:  "fix: x\n\nbody line one\nKey: value",
+		want: nil,
+	},
+	{
+		name: "signed_off_by_at_25_percent",
+		msg:  "fix: x\n\nNote one\nNote two\nNote three\nSigned-off-by: A <a@b.c>",
+		want: []string{"Signed-off-by: A <a@b.c>"},
+	},
+	{
+		name: "signed_off_by_below_25_percent",
+		msg:  "fix: x\n\nNote one\nNote two\nNote three\nNote four\nSigned-off-by: A <a@b.c>",
+		want: nil,
+	},
+	{
+		name: "cherry_picked_from",
+		msg:  "fix: x\n\nSome text\n(cherry picked from commit 0123456789abcdef0123456789abcdef01234567)\nFixes: #3",
+		want: []string{"Fixes: #3"},
+	},
+	{
+		name: "folded_value",
+		msg:  "fix: x\n\nFixes: #1\nCo-authored-by: Jane Doe\n  <jane@example.com>",
+		want: []string{"Fixes: #1", "Co-authored-by: Jane Doe <jane@example.com>"},
+	},
+	{
+		name: "folded_value_among_notes",
+		msg:  "fix: x\n\nbody\nSigned-off-by: A <a@b.c>\n  continued\nplain",
+		want: []string{"Signed-off-by: A <a@b.c> continued"},
+	},
+	{
+		name: "leading_continuation_line",
+		msg:  "fix: x\n\n  indented\nFixes: #1",
+		want: nil,
+	},
+	{
+		name: "space_before_separator",
+		msg:  "fix: x\n\nFixes : #1",
+		want: []string{"Fixes: #1"},
+	},
+	{
+		name: "url_is_a_trailer",
+		msg:  "fix: x\n\nhttps://example.com/a",
+		want: []string{"https: //example.com/a"},
+	},
+	{
+		name: "key_with_spaces",
+		msg:  "fix: x\n\nNot A Key: value",
+		want: nil,
+	},
+	{
+		name: "only_body",
+		msg:  "fix: x\n\nJust a body line.",
+		want: nil,
+	},
+	{
+		name: "comment_lines_ignored",
+		msg:  "fix: x\n\nFixes: #1\n# a comment\nRefs: #2",
+		want: []string{"Fixes: #1", "Refs: #2"},
+	},
+	{
+		name: "trailers_not_in_last_paragraph",
+		msg:  "fix: x\n\nFixes: #1\n\nFinal paragraph.",
+		want: nil,
+	},
+	{
+		name: "custom_separators",
+		cfg:  &conventional.TrailerConfig{Separators: ":#", CommentChar: '#'},
+		msg:  "fix: x\n\nBug #42\nFixes: #1",
+		want: []string{"Bug: 42", "Fixes: #1"},
+	},
+	{
+		name: "configured_key_recognized",
+		cfg:  &conventional.TrailerConfig{Keys: []string{"Fixes"}, CommentChar: '#'},
+		msg:  "fix: x\n\nNote one\nNote two\nNote three\nFixes: #1",
+		want: []string{"Fixes: #1"},
+	},
+	{
+		name: "unconfigured_key_not_recognized",
+		msg:  "fix: x\n\nNote one\nNote two\nNote three\nFixes: #1",
+		want: nil,
+	},
+}
+
+func TestParseMessage_TrailerConformance(t *testing.T) {
+	for _, tt := range trailerCorpus {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := conventional.DefaultParseConfig
+			if tt.cfg != nil {
+				cfg.Trailers = *tt.cfg
+			}
+			msg, err := conventional.ParseMessageWithConfig(tt.msg, cfg)
+			if err != nil {
+				t.Fatalf("ParseMessageWithConfig() error = %v", err)
+			}
+
+			got := make([]string, len(msg.Trailers))
+			for i, tr := range msg.Trailers {
+				got[i] = tr.String()
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Trailers = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTrailers(t *testing.T) {
+	for _, tt := range trailerCorpus {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := conventional.DefaultTrailerConfig
+			if tt.cfg != nil {
+				cfg = *tt.cfg
+			}
+			// The corpus holds for any title, Conventional Commit or not.
+			msg := "Update docs\nsecond title line" + strings.TrimPrefix(tt.msg, "fix: x")
+			trailers, err := conventional.ParseTrailers(msg, cfg)
+			if err != nil {
+				t.Fatalf("ParseTrailers() error = %v", err)
+			}
+
+			got := make([]string, len(trailers))
+			for i, tr := range trailers {
+				got[i] = tr.String()
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("ParseTrailers() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if trailers, err := conventional.ParseTrailers("Fixes: #1\nRefs: #2", conventional.DefaultTrailerConfig); err != nil || trailers != nil {
+		t.Errorf("ParseTrailers(title only) = %v, %v; want none", trailers, err)
+	}
+}
+
+func TestParseMessage_TrailerBlockSplitsBody(t *testing.T) {
+	msg, err := conventional.ParseMessage("fix: x\n\nSome body text.\n\nNote\nSigned-off-by: A <a@b.c>")
+	if err != nil {
+		t.Fatalf("ParseMessage() error = %v", err)
+	}
+	if msg.Body != "Some body text." {
+		t.Errorf("Body = %q, want %q", msg.Body, "Some body text.")
+	}
+
+	msg, err = conventional.ParseMessage("fix: x\n\nFixes: #1\nnot a trailer line")
+	if err != nil {
+		t.Fatalf("ParseMessage() error = %v", err)
+	}
+	if msg.Body != "Fixes: #1\nnot a trailer line" {
+		t.Errorf("Body = %q, want the whole paragraph", msg.Body)
+	}
+}
+
+func TestParseMessage_BreakingChangeFooterIsTrailer(t *testing.T) {
+	msg, err := conventional.ParseMessage("feat: x\n\nBREAKING CHANGE: drops y\nRefs: #2")
+	if err != nil {
+		t.Fatalf("ParseMessage() error = %v", err)
+	}
+	if !msg.Breaking || len(msg.Trailers) != 2 {
+		t.Errorf("Breaking = %v, Trailers = %v", msg.Breaking, msg.Trailers)
+	}
+}
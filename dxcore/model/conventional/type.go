@@ -19,8 +19,10 @@ package conventional
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"strings"
 
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
 	"gopkg.in/yaml.v3"
 )
@@ -270,7 +272,8 @@ const (
 // string, if the input contains only whitespace characters, or if the normalized
 // input does not match any known type name. The error message includes the
 // original invalid input (before normalization) to aid debugging and provide
-// clear feedback to users about what they provided.
+// clear feedback to users about what they provided. Errors are
+// *dxerrors.ValidationError values with CodeRequired or CodeOutOfRange.
 //
 // Callers MUST check the returned error before using the Type value. The zero
 // value returned on error (Type(0), which equals Feat) MUST NOT be used when
@@ -290,7 +293,11 @@ const (
 func ParseType(s string) (Type, error) {
 	// Validate that input is not empty before normalization
 	if s == "" {
-		return 0, fmt.Errorf("Type string cannot be empty")
+		return 0, &dxerrors.ValidationError{
+			Code:   dxerrors.CodeRequired,
+			Type:   Type(0).TypeName(),
+			Reason: "Type string cannot be empty",
+		}
 	}
 
 	// Normalize input: trim whitespace and convert to lowercase
@@ -298,7 +305,12 @@ func ParseType(s string) (Type, error) {
 
 	// Check if normalized result is empty (input was only whitespace)
 	if normalized == "" {
-		return 0, fmt.Errorf("Type string cannot contain only whitespace: %q", s)
+		return 0, &dxerrors.ValidationError{
+			Code:   dxerrors.CodeRequired,
+			Type:   Type(0).TypeName(),
+			Reason: fmt.Sprintf("Type string cannot contain only whitespace: %q", s),
+			Value:  s,
+		}
 	}
 
 	switch normalized {
@@ -325,7 +337,12 @@ func ParseType(s string) (Type, error) {
 	case RevertStr:
 		return Revert, nil
 	default:
-		return 0, fmt.Errorf("unknown Type: %q (normalized: %q)", s, normalized)
+		return 0, &dxerrors.ValidationError{
+			Code:   dxerrors.CodeOutOfRange,
+			Type:   Type(0).TypeName(),
+			Reason: fmt.Sprintf("unknown Type: %q (normalized: %q)", s, normalized),
+			Value:  s,
+		}
 	}
 }
 
@@ -396,6 +413,11 @@ func (t Type) Redacted() string {
 	return t.String()
 }
 
+// LogValue implements slog.LogValuer, logging the Type as its Redacted form.
+func (t Type) LogValue() slog.Value {
+	return slog.StringValue(t.Redacted())
+}
+
 // TypeName returns the canonical name of this model type for debugging,
 // logging, and reflection purposes. This method satisfies the model.Identifiable
 // interface's TypeName requirement.
@@ -493,7 +515,12 @@ func (t Type) Equal(other Type) bool {
 //	}
 func (t Type) Validate() error {
 	if t >= maxType {
-		return fmt.Errorf("Type value %d is out of valid range [0, %d)", t, maxType)
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeOutOfRange,
+			Type:   t.TypeName(),
+			Reason: fmt.Sprintf("Type value %d is out of valid range [0, %d)", t, maxType),
+			Value:  int(t),
+		}
 	}
 	return nil
 }
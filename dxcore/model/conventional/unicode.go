@@ -0,0 +1,250 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model"
+)
+
+// LengthMode selects how the length of human-facing text such as a Subject
+// is measured.
+type LengthMode int
+
+const (
+	// LengthRunes counts Unicode code points. It is the measure used by
+	// Subject.Validate and SubjectMaxLen.
+	LengthRunes LengthMode = iota
+
+	// LengthGraphemes counts user-perceived characters (extended grapheme
+	// clusters), so that "é" written as "e" plus a combining accent, or a
+	// family emoji joined with ZWJ, counts as one.
+	LengthGraphemes
+
+	// LengthDisplayWidth counts terminal columns: East Asian wide and
+	// fullwidth characters and emoji take two columns, combining marks and
+	// invisible characters none.
+	LengthDisplayWidth
+)
+
+// String returns "runes", "graphemes" or "width", or "unknown" for undefined
+// values.
+func (m LengthMode) String() string {
+	switch m {
+	case LengthRunes:
+		return "runes"
+	case LengthGraphemes:
+		return "graphemes"
+	case LengthDisplayWidth:
+		return "width"
+	default:
+		return "unknown"
+	}
+}
+
+// Valid reports whether m is one of the defined constants.
+func (m LengthMode) Valid() bool {
+	return m >= LengthRunes && m <= LengthDisplayWidth
+}
+
+// TextLength returns the length of s measured according to mode. Undefined
+// modes count runes.
+func TextLength(s string, mode LengthMode) int {
+	switch mode {
+	case LengthGraphemes:
+		return GraphemeCount(s)
+	case LengthDisplayWidth:
+		return DisplayWidth(s)
+	default:
+		return utf8.RuneCountInString(s)
+	}
+}
+
+// SubjectOptions configures Unicode-aware Subject parsing and validation.
+// The zero value reproduces ParseSubject and Subject.Validate.
+type SubjectOptions struct {
+	// Length selects how the subject length is measured.
+	Length LengthMode
+
+	// MaxLen is the maximum length in Length units. Zero means
+	// SubjectMaxLen.
+	MaxLen int
+
+	// Normalize applies NormalizeSubject before validation.
+	Normalize bool
+
+	// CheckUnicode rejects the bidirectional, invisible, control and
+	// mixed-script characters reported by model.ScanText, with one
+	// *errors.ParseError per character. On the options of a Message (see
+	// ParseConfig.Subject and Message.WithSubjectOptions), Validate applies
+	// it to the body and trailer values as well.
+	CheckUnicode bool
+}
+
+// SubjectOptions returns the options Validate measures the subject with:
+// those of the ParseConfig the message was parsed with, or those set with
+// WithSubjectOptions. They are the zero value for messages built otherwise.
+func (m Message) SubjectOptions() SubjectOptions {
+	return m.subjectOpts
+}
+
+// WithSubjectOptions returns a copy of m whose subject is validated with
+// opts. The options are not serialized; decoding JSON or YAML into a
+// message keeps the options it already has.
+func (m Message) WithSubjectOptions(opts SubjectOptions) Message {
+	m.subjectOpts = opts
+	return m
+}
+
+// ParseSubjectWith is like ParseSubject but normalizes and validates s
+// according to opts.
+func ParseSubjectWith(s string, opts SubjectOptions) (Subject, error) {
+	desc := Subject(strings.TrimSpace(s))
+	if opts.Normalize {
+		desc = NormalizeSubject(s)
+	}
+	if err := desc.ValidateWith(opts); err != nil {
+		return "", err
+	}
+	return desc, nil
+}
+
+// SubjectMaxBytes bounds the UTF-8 size of subjects validated by ValidateWith,
+// whose grapheme and display-width modes count a whole cluster as one unit
+// however many code points it spans.
+const SubjectMaxBytes = 1024
+
+// ValidateWith is like Validate but measures the subject according to opts.
+// The structural checks of Validate (no newlines, not only whitespace) apply
+// in every mode, together with the SubjectMaxBytes size limit; the length
+// limit is then only the one of opts. A subject of many combining sequences
+// or emoji can therefore pass ValidateWith in grapheme or display-width mode
+// while exceeding the SubjectMaxLen runes required by Validate.
+func (d Subject) ValidateWith(opts SubjectOptions) error {
+	if d.IsZero() {
+		return nil
+	}
+	if !opts.Length.Valid() {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeOutOfRange,
+			Type:   "SubjectOptions",
+			Field:  "Length",
+			Reason: fmt.Sprintf("invalid length mode %d", int(opts.Length)),
+			Value:  int(opts.Length),
+		}
+	}
+	if err := d.checkShape(); err != nil {
+		return err
+	}
+	if len(d) > SubjectMaxBytes {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeTooLong,
+			Type:   d.TypeName(),
+			Reason: fmt.Sprintf("Subject is too large: %d bytes (maximum: %d bytes)", len(d), SubjectMaxBytes),
+			Value:  string(d),
+		}
+	}
+
+	maxLen := opts.MaxLen
+	if maxLen <= 0 {
+		maxLen = SubjectMaxLen
+	}
+	n := TextLength(string(d), opts.Length)
+	if n < SubjectMinLen {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeTooShort,
+			Type:   d.TypeName(),
+			Reason: fmt.Sprintf("Subject is too short: %d %s (minimum: %d)", n, opts.Length, SubjectMinLen),
+			Value:  string(d),
+		}
+	}
+	if n > maxLen {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeTooLong,
+			Type:   d.TypeName(),
+			Reason: fmt.Sprintf("Subject is too long: %d %s (maximum: %d)", n, opts.Length, maxLen),
+			Value:  string(d),
+		}
+	}
+	if opts.CheckUnicode {
+		return model.CheckText(d.TypeName(), string(d))
+	}
+	return nil
+}
+
+// NormalizeSubject returns s normalized with NormalizeText, with leading and
+// trailing whitespace removed.
+func NormalizeSubject(s string) Subject {
+	return Subject(strings.TrimSpace(NormalizeText(s)))
+}
+
+// NormalizeText makes s safe to display in changelogs and release notes:
+//
+//   - the control, bidirectional and invisible characters reported by
+//     model.ScanText are removed, so text cannot be visually reordered or
+//     hide content; tab and line feed, joiners within emoji sequences and
+//     scripts such as Persian, and emoji tag sequences are kept
+//   - carriage returns are removed
+//   - the result is put in Unicode Normalization Form C (see NFC)
+//
+// Mixed-script words reported by ScanText are left as they are, since no
+// character of theirs can be removed safely.
+func NormalizeText(s string) string {
+	var b strings.Builder
+	last := 0
+	for _, c := range model.ScanText(s) {
+		if c.Kind == model.UnsafeHomoglyph {
+			continue
+		}
+		b.WriteString(s[last:c.Offset])
+		last = c.Offset + utf8.RuneLen(c.Rune)
+	}
+	b.WriteString(s[last:])
+	return NFC(strings.ReplaceAll(b.String(), "\r", ""))
+}
+
+// NFC returns s in Unicode Normalization Form C: canonically equivalent
+// sequences such as "e" followed by U+0301 COMBINING ACUTE ACCENT and the
+// precomposed "é" are both represented by the latter, so that strings which
+// look the same also compare equal. Invalid UTF-8 is replaced by U+FFFD.
+func NFC(s string) string {
+	return norm.NFC.String(strings.ToValidUTF8(s, "\uFFFD"))
+}
+
+// GraphemeCount returns the number of extended grapheme clusters in s, as
+// defined by Unicode Standard Annex #29: combining marks, emoji modifiers and
+// ZWJ emoji sequences extend the preceding cluster, regional indicators pair
+// into flags, and Hangul jamo combine into syllables.
+func GraphemeCount(s string) int {
+	return uniseg.GraphemeClusterCount(s)
+}
+
+// DisplayWidth returns the number of terminal columns needed to display s:
+// two for clusters that are East Asian wide or fullwidth or presented as
+// emoji (including flags), one for other printable clusters, and zero for
+// control characters and clusters made only of combining marks or invisible
+// characters.
+func DisplayWidth(s string) int {
+	return uniseg.StringWidth(s)
+}
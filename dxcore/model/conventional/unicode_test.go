@@ -0,0 +1,257 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"gopkg.in/yaml.v3"
+)
+
+func TestNFC(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"ascii", "fix parser", "fix parser"},
+		{"combining_acute", "cafe\u0301", "caf\u00e9"},
+		{"already_composed", "caf\u00e9", "caf\u00e9"},
+		{"reorder_marks", "a\u0302\u0323", "\u1ead"},
+		{"decompose_then_compose", "\u00e9\u0323", "\u1eb9\u0301"},
+		{"singleton", "\u212b", "\u00c5"},
+		{"composition_exclusion", "\u0958", "\u0915\u093c"},
+		{"hangul_jamo", "\u1100\u1161\u11a8", "\uac01"},
+		{"hangul_lv_t", "\uac00\u11a8", "\uac01"},
+		{"blocked_by_starter", "eA\u0301", "e\u00c1"},
+		{"invalid_utf8", "a\xffb", "a\ufffdb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := conventional.NFC(tt.in); got != tt.want {
+				t.Errorf("NFC(%+q) = %+q, want %+q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraphemeCountAndDisplayWidth(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		runes     int
+		graphemes int
+		width     int
+	}{
+		{"ascii", "add search", 10, 10, 10},
+		{"combining", "cafe\u0301", 5, 4, 4},
+		{"cjk", "\u65b0\u6a5f\u80fd", 3, 3, 6},
+		{"fullwidth", "\uff21\uff22", 2, 2, 4},
+		{"emoji", "\u2728 add", 5, 5, 6},
+		{"emoji_variation", "\u267b\ufe0f x", 4, 3, 4},
+		{"zwj_family", "\U0001f468\u200d\U0001f469\u200d\U0001f467", 5, 1, 2},
+		{"skin_tone", "\U0001f44d\U0001f3fd", 2, 1, 2},
+		{"flags", "\U0001f1e9\U0001f1ea\U0001f1eb\U0001f1f7", 4, 2, 4},
+		{"hangul_jamo", "\u1100\u1161\u11a8", 3, 1, 2},
+		{"invisible", "a\u200bb", 3, 3, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := conventional.TextLength(tt.in, conventional.LengthRunes); got != tt.runes {
+				t.Errorf("TextLength(runes) = %d, want %d", got, tt.runes)
+			}
+			if got := conventional.GraphemeCount(tt.in); got != tt.graphemes {
+				t.Errorf("GraphemeCount() = %d, want %d", got, tt.graphemes)
+			}
+			if got := conventional.DisplayWidth(tt.in); got != tt.width {
+				t.Errorf("DisplayWidth() = %d, want %d", got, tt.width)
+			}
+		})
+	}
+}
+
+func TestNormalizeText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "add search", "add search"},
+		{"nfc", "cafe\u0301", "caf\u00e9"},
+		{"zero_width_space", "fix\u200b parser", "fix parser"},
+		{"bom_and_soft_hyphen", "\ufeffre\u00adview", "review"},
+		{"bidi_override", "fix \u202eevil\u202c", "fix evil"},
+		{"bidi_isolate", "a\u2067b\u2069c", "abc"},
+		{"zwj_between_ascii", "ad\u200dmin", "admin"},
+		{"zwj_emoji_sequence", "\U0001f468\u200d\U0001f469", "\U0001f468\u200d\U0001f469"},
+		{"zwnj_persian", "\u0645\u06cc\u200c\u062e\u0648\u0627\u0647\u0645", "\u0645\u06cc\u200c\u062e\u0648\u0627\u0647\u0645"},
+		{"tags_smuggled", "ok\U000e0041\U000e0042", "ok"},
+		{"tags_flag", "\U0001f3f4\U000e0067\U000e0062\U000e007f", "\U0001f3f4\U000e0067\U000e0062\U000e007f"},
+		{"controls", "a\x00b\x1bc\td", "abc\td"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := conventional.NormalizeText(tt.in); got != tt.want {
+				t.Errorf("NormalizeText(%+q) = %+q, want %+q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubject_ValidateWith(t *testing.T) {
+	cjk := conventional.Subject(strings.Repeat("\u65b0", 40))
+	combining := conventional.Subject(strings.Repeat("e\u0301", 30))
+	family := "\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466"
+
+	tests := []struct {
+		name    string
+		subject conventional.Subject
+		opts    conventional.SubjectOptions
+		wantErr bool
+	}{
+		{"runes_default", cjk, conventional.SubjectOptions{}, false},
+		{"width_rejects_wide", cjk, conventional.SubjectOptions{Length: conventional.LengthDisplayWidth}, true},
+		{"width_custom_max", cjk, conventional.SubjectOptions{Length: conventional.LengthDisplayWidth, MaxLen: 80}, false},
+		{"graphemes", combining, conventional.SubjectOptions{Length: conventional.LengthGraphemes, MaxLen: 30}, false},
+		{"graphemes_too_long", combining, conventional.SubjectOptions{Length: conventional.LengthGraphemes, MaxLen: 29}, true},
+		{"graphemes_over_rune_limit", conventional.Subject(strings.Repeat("e\u0301", 50)), conventional.SubjectOptions{Length: conventional.LengthGraphemes}, false},
+		{"graphemes_zwj_emoji", conventional.Subject(strings.Repeat(family, 15)), conventional.SubjectOptions{Length: conventional.LengthGraphemes}, false},
+		{"width_over_rune_limit", conventional.Subject(strings.Repeat("e\u0301", 50)), conventional.SubjectOptions{Length: conventional.LengthDisplayWidth}, false},
+		{"runes_custom_max", conventional.Subject(strings.Repeat("e\u0301", 50)), conventional.SubjectOptions{MaxLen: 100}, false},
+		{"runes_default_limit", conventional.Subject(strings.Repeat("e\u0301", 50)), conventional.SubjectOptions{}, true},
+		{"byte_cap", conventional.Subject(strings.Repeat("e"+strings.Repeat("\u0301", 20), 30)), conventional.SubjectOptions{Length: conventional.LengthGraphemes}, true},
+		{"newline", "a\nb", conventional.SubjectOptions{Length: conventional.LengthGraphemes}, true},
+		{"whitespace_only", "   ", conventional.SubjectOptions{Length: conventional.LengthDisplayWidth}, true},
+		{"invalid_mode", "x", conventional.SubjectOptions{Length: conventional.LengthMode(9)}, true},
+		{"zero_subject", "", conventional.SubjectOptions{Length: conventional.LengthDisplayWidth}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.subject.ValidateWith(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWith() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseMessageWithConfig_Subject(t *testing.T) {
+	cfg := conventional.DefaultParseConfig
+	cfg.Subject = conventional.SubjectOptions{Normalize: true, Length: conventional.LengthDisplayWidth}
+
+	m, err := conventional.ParseMessageWithConfig("fix: cafe\u0301 \u202eredirect\u202c", cfg)
+	if err != nil {
+		t.Fatalf("ParseMessageWithConfig() error = %v", err)
+	}
+	if want := conventional.Subject("caf\u00e9 redirect"); m.Subject != want {
+		t.Errorf("Subject = %+q, want %+q", m.Subject, want)
+	}
+
+	if _, err := conventional.ParseMessageWithConfig("feat: "+strings.Repeat("\u65b0", 40), cfg); err == nil {
+		t.Error("ParseMessageWithConfig() expected width error")
+	}
+
+	graphemes := conventional.DefaultParseConfig
+	graphemes.Subject = conventional.SubjectOptions{Length: conventional.LengthGraphemes}
+	long := strings.Repeat("e\u0301", 50)
+	if m, err := conventional.ParseMessageWithConfig("feat: "+long, graphemes); err != nil || string(m.Subject) != long {
+		t.Errorf("ParseMessageWithConfig() in grapheme mode = %+q, %v; want the 100-rune subject", m.Subject, err)
+	}
+
+	m, err = conventional.ParseMessage("fix: a\u200bb")
+	if err != nil {
+		t.Fatalf("ParseMessage() error = %v", err)
+	}
+	if m.Subject != "a\u200bb" {
+		t.Errorf("ParseMessage() normalized the subject without being asked: %+q", m.Subject)
+	}
+}
+
+func TestParseMessageWithConfig_RoundTrip(t *testing.T) {
+	tests := []struct {
+		mode    conventional.LengthMode
+		subject string
+	}{
+		{conventional.LengthRunes, strings.Repeat("x", conventional.SubjectMaxLen)},
+		{conventional.LengthGraphemes, strings.Repeat("e\u0301", 60)},
+		{conventional.LengthDisplayWidth, strings.Repeat("e\u0301", 40)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			cfg := conventional.DefaultParseConfig
+			cfg.Subject = conventional.SubjectOptions{Length: tt.mode}
+			m, err := conventional.ParseMessageWithConfig("feat: "+tt.subject+"\n\nRefs: #1", cfg)
+			if err != nil {
+				t.Fatalf("ParseMessageWithConfig() error = %v", err)
+			}
+			if got := m.SubjectOptions(); got != cfg.Subject {
+				t.Errorf("SubjectOptions() = %+v, want %+v", got, cfg.Subject)
+			}
+			if err := m.Validate(); err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			data, err := json.Marshal(m)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if _, err := yaml.Marshal(m); err != nil {
+				t.Fatalf("yaml.Marshal() error = %v", err)
+			}
+
+			got := conventional.Message{}.WithSubjectOptions(cfg.Subject)
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if !got.Equal(m) {
+				t.Errorf("round trip = %+v, want %+v", got, m)
+			}
+
+			built, err := conventional.NewMessageBuilder().
+				WithType(conventional.Feat).
+				WithSubjectOptions(cfg.Subject).
+				WithSubject(tt.subject).
+				Build()
+			if err != nil || !built.Subject.Equal(m.Subject) {
+				t.Errorf("Build() = %+v, %v", built, err)
+			}
+		})
+	}
+
+	// Without the options, the long subjects are too long in runes.
+	long := conventional.Message{Type: conventional.Feat, Subject: conventional.Subject(strings.Repeat("e\u0301", 60))}
+	if _, err := json.Marshal(long); err == nil {
+		t.Error("json.Marshal() accepted a 120-rune subject without SubjectOptions")
+	}
+}
+
+func TestLengthMode_String(t *testing.T) {
+	for mode, want := range map[conventional.LengthMode]string{
+		conventional.LengthRunes:        "runes",
+		conventional.LengthGraphemes:    "graphemes",
+		conventional.LengthDisplayWidth: "width",
+		conventional.LengthMode(-1):     "unknown",
+	} {
+		if got := mode.String(); got != want {
+			t.Errorf("LengthMode(%d).String() = %q, want %q", int(mode), got, want)
+		}
+	}
+}
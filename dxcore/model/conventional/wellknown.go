@@ -0,0 +1,234 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+)
+
+// Keys of well-known trailers. Lookups are case-insensitive, so these
+// constants only define the canonical spelling used when formatting.
+const (
+	// CoAuthoredByTrailerKey attributes a commit to an additional author.
+	CoAuthoredByTrailerKey = "Co-authored-by"
+
+	// SignedOffByTrailerKey records a Developer Certificate of Origin
+	// sign-off.
+	SignedOffByTrailerKey = "Signed-off-by"
+
+	// FixesTrailerKey references an issue the commit fixes.
+	FixesTrailerKey = "Fixes"
+
+	// ClosesTrailerKey references an issue the commit closes.
+	ClosesTrailerKey = "Closes"
+
+	// RefsTrailerKey references a related issue without closing it.
+	RefsTrailerKey = "Refs"
+
+	// BreakingChangeTrailerKey is the Conventional Commits breaking change
+	// footer. It contains a space and is therefore not a valid git trailer
+	// key; ParseMessage recognizes it specially.
+	BreakingChangeTrailerKey = "BREAKING CHANGE"
+
+	// BreakingChangeHyphenTrailerKey is the git-compatible spelling of
+	// BreakingChangeTrailerKey.
+	BreakingChangeHyphenTrailerKey = "BREAKING-CHANGE"
+)
+
+// TrailerKind describes how the value of a trailer is interpreted.
+type TrailerKind int
+
+const (
+	// TrailerText is free-form text. It is the kind of unregistered keys.
+	TrailerText TrailerKind = iota
+
+	// TrailerPerson is a "Name <email>" identity (see ParsePerson).
+	TrailerPerson
+
+	// TrailerIssue is a list of issue references (see ParseIssueRefs).
+	TrailerIssue
+)
+
+// String returns "text", "person" or "issue", or "unknown" for undefined
+// values.
+func (k TrailerKind) String() string {
+	switch k {
+	case TrailerText:
+		return "text"
+	case TrailerPerson:
+		return "person"
+	case TrailerIssue:
+		return "issue"
+	default:
+		return "unknown"
+	}
+}
+
+// Valid reports whether k is one of the defined constants.
+func (k TrailerKind) Valid() bool {
+	return k >= TrailerText && k <= TrailerIssue
+}
+
+// TrailerSpec describes a registered trailer key.
+type TrailerSpec struct {
+	// Key is the canonical spelling of the trailer key.
+	Key string
+
+	// Kind is how values of the trailer are interpreted.
+	Kind TrailerKind
+}
+
+var (
+	trailerRegistryMu sync.RWMutex
+
+	// trailerRegistry maps lower-cased keys to their spec.
+	trailerRegistry = map[string]TrailerSpec{}
+)
+
+func init() {
+	for _, spec := range []TrailerSpec{
+		{Key: CoAuthoredByTrailerKey, Kind: TrailerPerson},
+		{Key: SignedOffByTrailerKey, Kind: TrailerPerson},
+		{Key: "Reviewed-by", Kind: TrailerPerson},
+		{Key: "Acked-by", Kind: TrailerPerson},
+		{Key: "Tested-by", Kind: TrailerPerson},
+		{Key: "Reported-by", Kind: TrailerPerson},
+		{Key: "Helped-by", Kind: TrailerPerson},
+		{Key: FixesTrailerKey, Kind: TrailerIssue},
+		{Key: ClosesTrailerKey, Kind: TrailerIssue},
+		{Key: RefsTrailerKey, Kind: TrailerIssue},
+		{Key: BreakingChangeTrailerKey, Kind: TrailerText},
+		{Key: BreakingChangeHyphenTrailerKey, Kind: TrailerText},
+		{Key: RevertsTrailerKey, Kind: TrailerText},
+		{Key: ReleaseAsTrailerKey, Kind: TrailerText},
+		{Key: SkipReleaseTrailerKey, Kind: TrailerText},
+		{Key: DxrelTrailerKey, Kind: TrailerText},
+	} {
+		trailerRegistry[strings.ToLower(spec.Key)] = spec
+	}
+}
+
+// RegisterTrailer adds spec to the trailer registry, or replaces the spec
+// registered under the same key (compared case-insensitively). Repositories
+// use it to teach dxrel about their own trailers, for example registering
+// "Jira" as TrailerIssue or "Pair-programmed-with" as TrailerPerson.
+//
+// RegisterTrailer returns a *dxerrors.ValidationError of type "TrailerSpec"
+// if the key is not a valid trailer key or the kind is undefined. It is safe for concurrent use.
+func RegisterTrailer(spec TrailerSpec) error {
+	if spec.Key == "" {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeRequired,
+			Type:   "TrailerSpec",
+			Field:  "Key",
+			Reason: "TrailerSpec Key cannot be empty",
+		}
+	}
+	if err := (Trailer{Key: spec.Key}).Validate(); err != nil {
+		return dxerrors.Nest(err, "TrailerSpec", "")
+	}
+	if !spec.Kind.Valid() {
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeOutOfRange,
+			Type:   "TrailerSpec",
+			Field:  "Kind",
+			Reason: fmt.Sprintf("TrailerSpec Kind %d is not a defined TrailerKind", int(spec.Kind)),
+			Value:  int(spec.Kind),
+		}
+	}
+
+	trailerRegistryMu.Lock()
+	defer trailerRegistryMu.Unlock()
+	trailerRegistry[strings.ToLower(spec.Key)] = spec
+	return nil
+}
+
+// LookupTrailer returns the registered spec for key, compared
+// case-insensitively as git does. It reports false for unregistered keys.
+func LookupTrailer(key string) (TrailerSpec, bool) {
+	trailerRegistryMu.RLock()
+	defer trailerRegistryMu.RUnlock()
+	spec, ok := trailerRegistry[strings.ToLower(key)]
+	return spec, ok
+}
+
+// People returns the identities listed in trailers with the given key, in
+// message order. Values that are not valid "Name <email>" identities are
+// skipped.
+func (m Message) People(key string) []Person {
+	var people []Person
+	for _, v := range m.TrailerValues(key) {
+		if p, err := ParsePerson(v); err == nil {
+			people = append(people, p)
+		}
+	}
+	return people
+}
+
+// CoAuthors returns the identities from Co-authored-by trailers.
+func (m Message) CoAuthors() []Person {
+	return m.People(CoAuthoredByTrailerKey)
+}
+
+// SignedOffBy returns the identities from Signed-off-by trailers.
+func (m Message) SignedOffBy() []Person {
+	return m.People(SignedOffByTrailerKey)
+}
+
+// Issues returns the issue references listed in trailers with the given
+// key, in message order.
+func (m Message) Issues(key string) []IssueRef {
+	var refs []IssueRef
+	for _, v := range m.TrailerValues(key) {
+		refs = append(refs, ParseIssueRefs(v)...)
+	}
+	return refs
+}
+
+// IssueRefs returns the issue references from every trailer registered as
+// TrailerIssue (by default Fixes, Closes and Refs), in message order.
+func (m Message) IssueRefs() []IssueRef {
+	var refs []IssueRef
+	for _, tr := range m.Trailers {
+		if spec, ok := LookupTrailer(tr.Key); ok && spec.Kind == TrailerIssue {
+			refs = append(refs, ParseIssueRefs(tr.Value)...)
+		}
+	}
+	return refs
+}
+
+// BreakingChange returns the text of the BREAKING CHANGE (or
+// BREAKING-CHANGE) footers, joined by blank lines when there are several,
+// and reports whether any is present. A breaking change signalled only by
+// the "!" header marker has no text and yields ("", false).
+func (m Message) BreakingChange() (string, bool) {
+	var texts []string
+	found := false
+	for _, tr := range m.Trailers {
+		if strings.EqualFold(tr.Key, BreakingChangeTrailerKey) || strings.EqualFold(tr.Key, BreakingChangeHyphenTrailerKey) {
+			found = true
+			if tr.Value != "" {
+				texts = append(texts, tr.Value)
+			}
+		}
+	}
+	return strings.Join(texts, "\n\n"), found
+}
@@ -0,0 +1,138 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package conventional_test
+
+import (
+	"errors"
+	"testing"
+
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+)
+
+const wellKnownMessage = `feat(api): add search endpoint
+
+Adds a search endpoint backed by the new index.
+
+BREAKING CHANGE: the /find endpoint was removed
+  in favour of /search.
+Fixes: #12, owner/repo#4
+refs: https://github.com/owner/repo/issues/7
+co-authored-by: Jane Doe <jane@example.com>
+Co-authored-by: not a person
+Signed-off-by: John Smith
+ <john@example.com>`
+
+func TestMessage_WellKnownTrailers(t *testing.T) {
+	msg, err := conventional.ParseMessage(wellKnownMessage)
+	if err != nil {
+		t.Fatalf("ParseMessage() error = %v", err)
+	}
+
+	text, ok := msg.BreakingChange()
+	if !ok || text != "the /find endpoint was removed in favour of /search." {
+		t.Errorf("BreakingChange() = (%q, %v)", text, ok)
+	}
+
+	refs := msg.IssueRefs()
+	wantRefs := []string{"#12", "owner/repo#4", "https://github.com/owner/repo/issues/7"}
+	if len(refs) != len(wantRefs) {
+		t.Fatalf("IssueRefs() = %v, want %v", refs, wantRefs)
+	}
+	for i := range wantRefs {
+		if refs[i].String() != wantRefs[i] {
+			t.Errorf("IssueRefs()[%d] = %q, want %q", i, refs[i], wantRefs[i])
+		}
+	}
+	if got := msg.Issues(conventional.FixesTrailerKey); len(got) != 2 {
+		t.Errorf("Issues(Fixes) = %v, want 2 refs", got)
+	}
+
+	coAuthors := msg.CoAuthors()
+	if len(coAuthors) != 1 || coAuthors[0].String() != "Jane Doe <jane@example.com>" {
+		t.Errorf("CoAuthors() = %v", coAuthors)
+	}
+
+	signOffs := msg.SignedOffBy()
+	if len(signOffs) != 1 || signOffs[0].Email != "john@example.com" {
+		t.Errorf("SignedOffBy() = %v", signOffs)
+	}
+}
+
+func TestMessage_BreakingChange_MarkerOnly(t *testing.T) {
+	msg, err := conventional.ParseMessage("feat!: drop x")
+	if err != nil {
+		t.Fatalf("ParseMessage() error = %v", err)
+	}
+	if text, ok := msg.BreakingChange(); ok || text != "" {
+		t.Errorf("BreakingChange() = (%q, %v), want (\"\", false)", text, ok)
+	}
+}
+
+func TestParseMessage_IndentedBodyIsNotTrailers(t *testing.T) {
+	msg, err := conventional.ParseMessage("docs: add example\n\nUsage:\n\n    dxrel plan\n    dxrel release")
+	if err != nil {
+		t.Fatalf("ParseMessage() error = %v", err)
+	}
+	if len(msg.Trailers) != 0 {
+		t.Errorf("Trailers = %v, want none", msg.Trailers)
+	}
+}
+
+func TestLookupTrailer(t *testing.T) {
+	spec, ok := conventional.LookupTrailer("SIGNED-OFF-BY")
+	if !ok || spec.Kind != conventional.TrailerPerson || spec.Key != conventional.SignedOffByTrailerKey {
+		t.Errorf("LookupTrailer(SIGNED-OFF-BY) = (%+v, %v)", spec, ok)
+	}
+	if _, ok := conventional.LookupTrailer("X-Unknown"); ok {
+		t.Error("LookupTrailer(X-Unknown) reported true")
+	}
+}
+
+func TestRegisterTrailer(t *testing.T) {
+	if err := conventional.RegisterTrailer(conventional.TrailerSpec{Key: "Jira", Kind: conventional.TrailerIssue}); err != nil {
+		t.Fatalf("RegisterTrailer() error = %v", err)
+	}
+	msg, err := conventional.ParseMessage("fix: repair x\n\njira: https://jira.example.com/browse/ABC-1")
+	if err != nil {
+		t.Fatalf("ParseMessage() error = %v", err)
+	}
+	if refs := msg.IssueRefs(); len(refs) != 1 {
+		t.Errorf("IssueRefs() = %v, want the Jira link", refs)
+	}
+
+	invalid := []struct {
+		spec conventional.TrailerSpec
+		path string
+		code dxerrors.Code
+	}{
+		{conventional.TrailerSpec{Kind: conventional.TrailerText}, "TrailerSpec.Key", dxerrors.CodeRequired},
+		{conventional.TrailerSpec{Key: "bad key", Kind: conventional.TrailerText}, "TrailerSpec.Key", dxerrors.CodeFormat},
+		{conventional.TrailerSpec{Key: "Good", Kind: conventional.TrailerKind(99)}, "TrailerSpec.Kind", dxerrors.CodeOutOfRange},
+	}
+	for _, tt := range invalid {
+		err := conventional.RegisterTrailer(tt.spec)
+		var ve *dxerrors.ValidationError
+		if !errors.As(err, &ve) {
+			t.Errorf("RegisterTrailer(%+v) error = %v, want *ValidationError", tt.spec, err)
+			continue
+		}
+		if ve.Path() != tt.path || ve.Code != tt.code {
+			t.Errorf("RegisterTrailer(%+v) error = %s %q, want %s %q", tt.spec, ve.Code, ve.Path(), tt.code, tt.path)
+		}
+	}
+}
@@ -19,6 +19,8 @@ package git
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"slices"
 	"strings"
 
 	"dirpx.dev/dxrel/dxcore/errors"
@@ -226,6 +228,14 @@ type Commit struct {
 // Compile-time assertion that Commit implements model.Model.
 var _ model.Model = (*Commit)(nil)
 
+// Compile-time assertions that Commit implements the optional model
+// interfaces.
+var (
+	_ model.Comparable[Commit] = Commit{}
+	_ model.Cloneable[Commit]  = Commit{}
+	_ model.Ordered[Commit]    = Commit{}
+)
+
 // NewCommit creates a new Commit with the given components, validating the
 // result before returning.
 //
@@ -328,7 +338,8 @@ func (c Commit) String() string {
 // interface's Redacted requirement.
 //
 // For Commit, the redacted output includes Hash (abbreviated), parent count,
-// author name (not email, to preserve some privacy), and summary. The
+// author name (not email, to preserve some privacy) as redacted by the
+// current model.RedactionPolicy, and summary. The
 // implementation delegates to the Redacted() methods of component types
 // (Hash, which abbreviates to 7 characters).
 //
@@ -348,10 +359,30 @@ func (c Commit) Redacted() string {
 	return fmt.Sprintf("Commit{Hash:%s, Parents:%d, Author:%s, Summary:%s}",
 		c.Hash.Redacted(),
 		len(c.Parents),
-		c.Author.Name,
+		model.RedactName(c.Author.Name),
 		c.Summary)
 }
 
+// LogValue implements slog.LogValuer, logging the Commit as a group of its
+// hash, abbreviated hash, author (redacted, see Signature.LogValue),
+// summary, and parent and change counts. Like Redacted, it omits the full
+// message and the committer.
+//
+// Example:
+//
+//	slog.Info("classified", "commit", commit)
+//	// commit.hash=a1b2c3d4... commit.short=a1b2c3d commit.author.name=Jane ...
+func (c Commit) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("hash", c.Hash.String()),
+		slog.String("short", c.Hash.Short()),
+		slog.Any("author", c.Author),
+		slog.String("summary", c.Summary),
+		slog.Int("parents", len(c.Parents)),
+		slog.Int("changes", len(c.Changes)),
+	)
+}
+
 // TypeName returns the canonical name of this model type for debugging,
 // logging, and reflection purposes. This method satisfies the model.Identifiable
 // interface's TypeName requirement.
@@ -460,6 +491,30 @@ func (c Commit) Equal(other Commit) bool {
 	return true
 }
 
+// Clone returns a deep copy of the Commit. The Parents and Changes slices
+// are copied, so that modifying the clone never affects the original. Nil
+// slices stay nil.
+//
+// Clone implements model.Cloneable[Commit].
+func (c Commit) Clone() Commit {
+	c.Parents = slices.Clone(c.Parents)
+	c.Changes = slices.Clone(c.Changes)
+	return c
+}
+
+// Compare orders commits chronologically: by Committer.When, then by Hash
+// to break ties between commits made in the same instant. Commits with the
+// same committer time and hash compare as equal; within one repository the
+// hash identifies the commit, so they are the same commit.
+//
+// Compare implements model.Ordered[Commit], for use with model.Sort.
+func (c Commit) Compare(other Commit) int {
+	if n := c.Committer.When.Compare(other.Committer.When); n != 0 {
+		return n
+	}
+	return c.Hash.Compare(other.Hash)
+}
+
 // Validate checks whether this Commit satisfies all model contracts and
 // invariants. This method implements the model.Validatable interface's
 // Validate requirement, enforcing data integrity for Git commit records.
@@ -517,158 +572,164 @@ func (c Commit) Equal(other Commit) bool {
 //	    log.Error("invalid commit", "error", err)
 //	}
 func (c Commit) Validate() error {
-	// Validate Hash
-	if c.Hash.IsZero() {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Hash",
-			Reason: "must not be empty",
-		}
-	}
-	if err := c.Hash.Validate(); err != nil {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Hash",
-			Reason: fmt.Sprintf("invalid: %v", err),
-		}
-	}
+	return errors.RunChecks(false, c.checks(false)...)
+}
 
-	// Validate Parents
-	if len(c.Parents) > CommitParentsMaxCount {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Parents",
-			Reason: fmt.Sprintf("has too many parents: %d (maximum %d)", len(c.Parents), CommitParentsMaxCount),
-		}
-	}
-	for i, parent := range c.Parents {
-		if parent.IsZero() {
-			return &errors.ValidationError{
-				Type:   c.TypeName(),
-				Field:  fmt.Sprintf("Parents[%d]", i),
-				Reason: "must not be empty",
+// ValidateFull is like Validate, but instead of stopping at the first
+// problem it reports one error per invalid field, joined with errors.Join
+// (see errors.Violations). Nested values are validated fully as well.
+func (c Commit) ValidateFull() error {
+	return errors.RunChecks(true, c.checks(true)...)
+}
+
+// checks returns the validation steps of Validate, one per field. When full
+// is set, nested values and slice elements report every violation.
+func (c Commit) checks(full bool) []errors.Check {
+	return []errors.Check{
+		// Validate Hash
+		func() error {
+			if c.Hash.IsZero() {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   c.TypeName(),
+					Field:  "Hash",
+					Reason: "must not be empty",
+				}
 			}
-		}
-		if err := parent.Validate(); err != nil {
-			return &errors.ValidationError{
-				Type:   c.TypeName(),
-				Field:  fmt.Sprintf("Parents[%d]", i),
-				Reason: fmt.Sprintf("invalid: %v", err),
+			return errors.Nest(c.Hash.Validate(), c.TypeName(), "Hash")
+		},
+		// Validate Parents
+		func() error {
+			if len(c.Parents) > CommitParentsMaxCount {
+				return &errors.ValidationError{
+					Code:   errors.CodeTooMany,
+					Type:   c.TypeName(),
+					Field:  "Parents",
+					Reason: fmt.Sprintf("has too many parents: %d (maximum %d)", len(c.Parents), CommitParentsMaxCount),
+				}
 			}
-		}
-	}
-
-	// Validate Author
-	if c.Author.IsZero() {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Author",
-			Reason: "must not be empty",
-		}
-	}
-	if err := c.Author.Validate(); err != nil {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Author",
-			Reason: fmt.Sprintf("invalid: %v", err),
-		}
-	}
-
-	// Validate Committer
-	if c.Committer.IsZero() {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Committer",
-			Reason: "must not be empty",
-		}
-	}
-	if err := c.Committer.Validate(); err != nil {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Committer",
-			Reason: fmt.Sprintf("invalid: %v", err),
-		}
-	}
-
-	// Validate Message
-	if c.Message == "" {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Message",
-			Reason: "must not be empty",
-		}
-	}
-	if len(c.Message) > CommitMessageMaxLen {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Message",
-			Reason: fmt.Sprintf("exceeds maximum length of %d bytes (got %d)", CommitMessageMaxLen, len(c.Message)),
-		}
-	}
-	// Check for CRLF or lone CR (should be normalized to LF)
-	if strings.Contains(c.Message, "\r\n") || strings.Contains(c.Message, "\r") {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Message",
-			Reason: "contains CRLF or CR line endings (must use LF)",
-		}
-	}
-
-	// Validate Summary
-	if c.Summary == "" {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Summary",
-			Reason: "must not be empty",
-		}
-	}
-	if len(c.Summary) > CommitSummaryMaxLen {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Summary",
-			Reason: fmt.Sprintf("exceeds maximum length of %d bytes (got %d)", CommitSummaryMaxLen, len(c.Summary)),
-		}
-	}
-	if strings.Contains(c.Summary, "\n") || strings.Contains(c.Summary, "\r") {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Summary",
-			Reason: "must not contain newlines",
-		}
-	}
-
-	// Validate Summary matches first line of Message
-	lines := strings.Split(c.Message, "\n")
-	if len(lines) > 0 {
-		expectedSummary := strings.TrimSpace(lines[0])
-		if c.Summary != expectedSummary {
-			return &errors.ValidationError{
-				Type:   c.TypeName(),
-				Field:  "Summary",
-				Reason: fmt.Sprintf("%q does not match first line of Message %q", c.Summary, expectedSummary),
+			return errors.Each(full, len(c.Parents), func(i int) error {
+				if c.Parents[i].IsZero() {
+					return &errors.ValidationError{
+						Code:   errors.CodeRequired,
+						Type:   c.TypeName(),
+						Field:  fmt.Sprintf("Parents[%d]", i),
+						Reason: "must not be empty",
+					}
+				}
+				return errors.Nest(c.Parents[i].Validate(), c.TypeName(), fmt.Sprintf("Parents[%d]", i))
+			})
+		},
+		// Validate Author
+		func() error {
+			if c.Author.IsZero() {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   c.TypeName(),
+					Field:  "Author",
+					Reason: "must not be empty",
+				}
+			}
+			return errors.Nest(errors.ValidateMode(c.Author, full), c.TypeName(), "Author")
+		},
+		// Validate Committer
+		func() error {
+			if c.Committer.IsZero() {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   c.TypeName(),
+					Field:  "Committer",
+					Reason: "must not be empty",
+				}
+			}
+			return errors.Nest(errors.ValidateMode(c.Committer, full), c.TypeName(), "Committer")
+		},
+		// Validate Message
+		func() error {
+			if c.Message == "" {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   c.TypeName(),
+					Field:  "Message",
+					Reason: "must not be empty",
+				}
+			}
+			if len(c.Message) > CommitMessageMaxLen {
+				return &errors.ValidationError{
+					Code:   errors.CodeTooLong,
+					Type:   c.TypeName(),
+					Field:  "Message",
+					Reason: fmt.Sprintf("exceeds maximum length of %d bytes (got %d)", CommitMessageMaxLen, len(c.Message)),
+				}
+			}
+			// Check for CRLF or lone CR (should be normalized to LF)
+			if strings.Contains(c.Message, "\r\n") || strings.Contains(c.Message, "\r") {
+				return &errors.ValidationError{
+					Code:   errors.CodeFormat,
+					Type:   c.TypeName(),
+					Field:  "Message",
+					Reason: "contains CRLF or CR line endings (must use LF)",
+				}
+			}
+			return nil
+		},
+		// Validate Summary
+		func() error {
+			if c.Summary == "" {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   c.TypeName(),
+					Field:  "Summary",
+					Reason: "must not be empty",
+				}
+			}
+			if len(c.Summary) > CommitSummaryMaxLen {
+				return &errors.ValidationError{
+					Code:   errors.CodeTooLong,
+					Type:   c.TypeName(),
+					Field:  "Summary",
+					Reason: fmt.Sprintf("exceeds maximum length of %d bytes (got %d)", CommitSummaryMaxLen, len(c.Summary)),
+				}
+			}
+			if strings.Contains(c.Summary, "\n") || strings.Contains(c.Summary, "\r") {
+				return &errors.ValidationError{
+					Code:   errors.CodeFormat,
+					Type:   c.TypeName(),
+					Field:  "Summary",
+					Reason: "must not contain newlines",
+				}
 			}
-		}
-	}
 
-	// Validate Changes
-	if len(c.Changes) > CommitChangesMaxCount {
-		return &errors.ValidationError{
-			Type:   c.TypeName(),
-			Field:  "Changes",
-			Reason: fmt.Sprintf("has too many changes: %d (maximum %d)", len(c.Changes), CommitChangesMaxCount),
-		}
-	}
-	for i, change := range c.Changes {
-		if err := change.Validate(); err != nil {
-			return &errors.ValidationError{
-				Type:   c.TypeName(),
-				Field:  fmt.Sprintf("Changes[%d]", i),
-				Reason: fmt.Sprintf("invalid: %v", err),
+			// Validate Summary matches first line of Message
+			lines := strings.Split(c.Message, "\n")
+			if len(lines) > 0 {
+				expectedSummary := strings.TrimSpace(lines[0])
+				if c.Summary != expectedSummary {
+					return &errors.ValidationError{
+						Code:   errors.CodeInconsistent,
+						Type:   c.TypeName(),
+						Field:  "Summary",
+						Reason: fmt.Sprintf("%q does not match first line of Message %q", c.Summary, expectedSummary),
+					}
+				}
 			}
-		}
+			return nil
+		},
+		// Validate Changes
+		func() error {
+			if len(c.Changes) > CommitChangesMaxCount {
+				return &errors.ValidationError{
+					Code:   errors.CodeTooMany,
+					Type:   c.TypeName(),
+					Field:  "Changes",
+					Reason: fmt.Sprintf("has too many changes: %d (maximum %d)", len(c.Changes), CommitChangesMaxCount),
+				}
+			}
+			return errors.Each(full, len(c.Changes), func(i int) error {
+				return errors.Nest(errors.ValidateMode(c.Changes[i], full), c.TypeName(), fmt.Sprintf("Changes[%d]", i))
+			})
+		},
 	}
-
-	return nil
 }
 
 // MarshalJSON implements json.Marshaler, serializing the Commit to JSON
@@ -736,6 +797,7 @@ func (c *Commit) UnmarshalJSON(data []byte) error {
 			Type:   c.TypeName(),
 			Data:   data,
 			Reason: fmt.Sprintf("validation failed: %v", err),
+			Err:    err,
 		}
 	}
 
@@ -806,6 +868,7 @@ func (c *Commit) UnmarshalYAML(node *yaml.Node) error {
 			Type:   c.TypeName(),
 			Data:   []byte(fmt.Sprintf("%v", node)),
 			Reason: fmt.Sprintf("validation failed: %v", err),
+			Err:    err,
 		}
 	}
 
@@ -19,6 +19,7 @@ package git
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 
 	"dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
@@ -283,6 +284,15 @@ func (cr CommitRange) Redacted() string {
 	return fmt.Sprintf("%s..%s", fromStr, toStr)
 }
 
+// LogValue implements slog.LogValuer, logging the CommitRange as a group of
+// its From and To refs (see Ref.LogValue).
+func (cr CommitRange) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("from", cr.From),
+		slog.Any("to", cr.To),
+	)
+}
+
 // TypeName returns the string "CommitRange", which identifies this type in
 // logs, error messages, and serialized output. This method implements the
 // model.Identifiable contract through model.Model.
@@ -388,6 +398,14 @@ func (cr CommitRange) Equal(other any) bool {
 	}
 }
 
+// Clone returns a copy of the CommitRange. Both boundaries are Refs, which
+// hold only values, so the copy shares nothing with the original.
+//
+// Clone implements model.Cloneable[CommitRange].
+func (cr CommitRange) Clone() CommitRange {
+	return cr
+}
+
 // Validate checks that the CommitRange satisfies all structural and semantic
 // constraints, returning nil if valid or an error describing the first
 // validation failure encountered. This method implements the model.Validatable
@@ -421,45 +439,56 @@ func (cr CommitRange) Equal(other any) bool {
 //	cr = git.CommitRange{From: fromRef, To: git.Ref{}}
 //	err := cr.Validate()  // Returns error about zero To
 func (cr CommitRange) Validate() error {
+	return cr.validate(false)
+}
+
+// ValidateFull is like Validate, but instead of stopping at the first
+// problem it reports one error per invalid boundary, joined with
+// errors.Join (see errors.Violations).
+func (cr CommitRange) ValidateFull() error {
+	return cr.validate(true)
+}
+
+// validate implements Validate and, when full is set, ValidateFull.
+func (cr CommitRange) validate(full bool) error {
 	// Zero value is invalid - at minimum need a To boundary
 	if cr.IsZero() {
 		return &errors.ValidationError{
+			Code:   errors.CodeRequired,
 			Type:   cr.TypeName(),
 			Field:  "",
 			Reason: "is zero (both From and To are zero)",
 		}
 	}
 
-	// To MUST be non-zero
-	if cr.To.IsZero() {
-		return &errors.ValidationError{
-			Type:   cr.TypeName(),
-			Field:  "To",
-			Reason: "is zero (To boundary is required)",
-		}
-	}
-
-	// Validate To (required)
-	if err := cr.To.Validate(); err != nil {
-		return &errors.ValidationError{
-			Type:   cr.TypeName(),
-			Field:  "To",
-			Reason: fmt.Sprintf("invalid: %v", err),
-		}
-	}
-
-	// Validate From if non-zero (zero From is allowed = from beginning)
-	if !cr.From.IsZero() {
-		if err := cr.From.Validate(); err != nil {
-			return &errors.ValidationError{
-				Type:   cr.TypeName(),
-				Field:  "From",
-				Reason: fmt.Sprintf("invalid: %v", err),
+	return errors.RunChecks(full,
+		func() error {
+			// To MUST be non-zero
+			if cr.To.IsZero() {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   cr.TypeName(),
+					Field:  "To",
+					Reason: "is zero (To boundary is required)",
+				}
 			}
-		}
-	}
 
-	return nil
+			// Validate To (required)
+			if err := errors.ValidateMode(cr.To, full); err != nil {
+				return errors.Nest(err, cr.TypeName(), "To")
+			}
+			return nil
+		},
+		func() error {
+			// Validate From if non-zero (zero From is allowed = from beginning)
+			if !cr.From.IsZero() {
+				if err := errors.ValidateMode(cr.From, full); err != nil {
+					return errors.Nest(err, cr.TypeName(), "From")
+				}
+			}
+			return nil
+		},
+	)
 }
 
 // MarshalJSON serializes the CommitRange to JSON format after validating that
@@ -552,6 +581,7 @@ func (cr *CommitRange) UnmarshalJSON(data []byte) error {
 			Type:   cr.TypeName(),
 			Data:   data,
 			Reason: fmt.Sprintf("validation failed: %v", err),
+			Err:    err,
 		}
 	}
 
@@ -651,6 +681,7 @@ func (cr *CommitRange) UnmarshalYAML(node *yaml.Node) error {
 			Type:   cr.TypeName(),
 			Data:   []byte(fmt.Sprintf("%v", node)),
 			Reason: fmt.Sprintf("validation failed: %v", err),
+			Err:    err,
 		}
 	}
 
@@ -19,6 +19,7 @@ package git
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 
 	"dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
@@ -247,6 +248,15 @@ func (crs CommitRangeSpec) Redacted() string {
 	return fmt.Sprintf("%s..%s", fromStr, toStr)
 }
 
+// LogValue implements slog.LogValuer, logging the CommitRangeSpec as a group
+// of its From and To ref names.
+func (crs CommitRangeSpec) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("from", crs.From),
+		slog.Any("to", crs.To),
+	)
+}
+
 // TypeName returns the string "CommitRangeSpec", which identifies this type in
 // logs, error messages, and serialized output. This method implements the
 // model.Identifiable contract through model.Model.
@@ -380,45 +390,56 @@ func (crs CommitRangeSpec) Equal(other any) bool {
 //	spec = git.CommitRangeSpec{From: "v1.0.0", To: ""}
 //	err := spec.Validate()  // Returns error about empty To
 func (crs CommitRangeSpec) Validate() error {
+	return crs.validate(false)
+}
+
+// ValidateFull is like Validate, but instead of stopping at the first
+// problem it reports one error per invalid boundary, joined with
+// errors.Join (see errors.Violations).
+func (crs CommitRangeSpec) ValidateFull() error {
+	return crs.validate(true)
+}
+
+// validate implements Validate and, when full is set, ValidateFull.
+func (crs CommitRangeSpec) validate(full bool) error {
 	// Zero value is invalid - at minimum need a To ref name
 	if crs.IsZero() {
 		return &errors.ValidationError{
+			Code:   errors.CodeRequired,
 			Type:   crs.TypeName(),
 			Field:  "",
 			Reason: "is zero (both From and To are empty)",
 		}
 	}
 
-	// To MUST be non-empty
-	if crs.To.IsZero() {
-		return &errors.ValidationError{
-			Type:   crs.TypeName(),
-			Field:  "To",
-			Reason: "is empty (To ref name is required)",
-		}
-	}
-
-	// Validate To (required)
-	if err := crs.To.Validate(); err != nil {
-		return &errors.ValidationError{
-			Type:   crs.TypeName(),
-			Field:  "To",
-			Reason: fmt.Sprintf("invalid: %v", err),
-		}
-	}
-
-	// Validate From if non-empty (empty From is allowed = from beginning)
-	if !crs.From.IsZero() {
-		if err := crs.From.Validate(); err != nil {
-			return &errors.ValidationError{
-				Type:   crs.TypeName(),
-				Field:  "From",
-				Reason: fmt.Sprintf("invalid: %v", err),
+	return errors.RunChecks(full,
+		func() error {
+			// To MUST be non-empty
+			if crs.To.IsZero() {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   crs.TypeName(),
+					Field:  "To",
+					Reason: "is empty (To ref name is required)",
+				}
 			}
-		}
-	}
 
-	return nil
+			// Validate To (required)
+			if err := errors.ValidateMode(crs.To, full); err != nil {
+				return errors.Nest(err, crs.TypeName(), "To")
+			}
+			return nil
+		},
+		func() error {
+			// Validate From if non-empty (empty From is allowed = from beginning)
+			if !crs.From.IsZero() {
+				if err := errors.ValidateMode(crs.From, full); err != nil {
+					return errors.Nest(err, crs.TypeName(), "From")
+				}
+			}
+			return nil
+		},
+	)
 }
 
 // MarshalJSON serializes the CommitRangeSpec to JSON format after validating
@@ -513,6 +534,7 @@ func (crs *CommitRangeSpec) UnmarshalJSON(data []byte) error {
 			Type:   crs.TypeName(),
 			Data:   data,
 			Reason: fmt.Sprintf("validation failed: %v", err),
+			Err:    err,
 		}
 	}
 
@@ -608,6 +630,7 @@ func (crs *CommitRangeSpec) UnmarshalYAML(node *yaml.Node) error {
 			Type:   crs.TypeName(),
 			Data:   []byte(fmt.Sprintf("%v", node)),
 			Reason: fmt.Sprintf("validation failed: %v", err),
+			Err:    err,
 		}
 	}
 
@@ -17,11 +17,16 @@
 package git_test
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"log/slog"
 	"strings"
 	"testing"
 	"time"
 
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model"
 	"dirpx.dev/dxrel/dxcore/model/git"
 	"gopkg.in/yaml.v3"
 )
@@ -557,3 +562,193 @@ func TestCommit_CommonScenarios(t *testing.T) {
 		})
 	}
 }
+
+func TestCommit_Validate_FieldPath(t *testing.T) {
+	author := git.Signature{Name: "Jane", Email: "jane@example.com", When: time.Now()}
+	c := git.Commit{
+		Hash:      git.Hash("a1b2c3d4e5f67890abcdef1234567890abcdef12"),
+		Author:    author,
+		Committer: author,
+		Message:   "fix: bug",
+		Summary:   "fix: bug",
+		Changes: []git.FileChange{
+			{Path: "a.go", Kind: git.FileChangeAdded},
+			{Path: "b.go", Kind: git.FileChangeModified},
+			{Path: "c.go", Kind: git.FileChangeDeleted},
+			{Path: "/etc/passwd", Kind: git.FileChangeModified},
+		},
+	}
+
+	err := c.Validate()
+	var ve *dxerrors.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("Validate() = %v, want *ValidationError", err)
+	}
+	if got := ve.Path(); got != "Commit.Changes[3].Path" {
+		t.Errorf("Path() = %q, want %q", got, "Commit.Changes[3].Path")
+	}
+	if !errors.Is(err, dxerrors.CodeFormat) {
+		t.Errorf("errors.Is(err, CodeFormat) = false, err = %v", err)
+	}
+}
+
+func TestCommit_ValidateFull(t *testing.T) {
+	c := git.Commit{
+		Hash:      git.Hash("a1b2c3d4e5f67890abcdef1234567890abcdef12"),
+		Author:    git.Signature{Name: "", Email: "not an email", When: time.Now()},
+		Committer: git.Signature{Name: "John", Email: "john@example.com", When: time.Now()},
+		Message:   "fix: bug",
+		Summary:   "fix: bug",
+		Changes: []git.FileChange{
+			{Path: "/abs", Kind: git.FileChangeAdded},
+			{Path: "ok.go", Kind: git.FileChangeModified},
+			{Path: "", Kind: git.FileChangeModified},
+		},
+	}
+
+	if err := c.Validate(); len(dxerrors.Violations(err)) != 1 {
+		t.Fatalf("Validate() = %v, want a single error", err)
+	}
+
+	got := dxerrors.Violations(c.ValidateFull())
+	want := []string{
+		"Commit.Author.Name",
+		"Commit.Author.Email",
+		"Commit.Changes[0].Path",
+		"Commit.Changes[2].Path",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ValidateFull() reported %d violations, want %d: %v", len(got), len(want), got)
+	}
+	for i, err := range got {
+		var ve *dxerrors.ValidationError
+		if !errors.As(err, &ve) || ve.Path() != want[i] {
+			t.Errorf("violation %d = %v, want path %q", i, err, want[i])
+		}
+	}
+
+	c.Author.Name, c.Author.Email = "Jane", "jane@example.com"
+	c.Changes = c.Changes[1:2]
+	if err := c.ValidateFull(); err != nil {
+		t.Errorf("ValidateFull() = %v, want nil", err)
+	}
+}
+
+func TestCommit_Clone(t *testing.T) {
+	author := git.Signature{Name: "Jane", Email: "jane@example.com", When: time.Now()}
+	orig := git.Commit{
+		Hash:      git.Hash("a1b2c3d4e5f67890abcdef1234567890abcdef12"),
+		Parents:   []git.Hash{"1234567890abcdef1234567890abcdef12345678"},
+		Author:    author,
+		Committer: author,
+		Message:   "fix: bug",
+		Summary:   "fix: bug",
+		Changes:   []git.FileChange{{Path: "a.go", Kind: git.FileChangeModified}},
+	}
+
+	clone := orig.Clone()
+	if !clone.Equal(orig) {
+		t.Fatalf("Clone() = %+v, want %+v", clone, orig)
+	}
+	clone.Parents[0] = "ffffffffffffffffffffffffffffffffffffffff"
+	clone.Changes[0].Path = "b.go"
+	if orig.Parents[0] == clone.Parents[0] || orig.Changes[0].Path != "a.go" {
+		t.Error("Clone() shares slices with the original")
+	}
+
+	if c := (git.Commit{}).Clone(); c.Parents != nil || c.Changes != nil {
+		t.Errorf("Clone() of nil slices = %+v, want nil slices", c)
+	}
+
+	viaHelper, err := model.Clone(&orig)
+	if err != nil || viaHelper == &orig || !model.Equal(viaHelper, &orig) {
+		t.Fatalf("model.Clone() = %+v, %v", viaHelper, err)
+	}
+	viaHelper.Parents[0] = "ffffffffffffffffffffffffffffffffffffffff"
+	if orig.Parents[0] == viaHelper.Parents[0] {
+		t.Error("model.Clone() shares slices with the original")
+	}
+
+	all := model.CloneAll([]git.Commit{orig, clone})
+	if !model.EqualAll(all, []git.Commit{orig, clone}) || model.EqualAll(all, []git.Commit{orig}) {
+		t.Fatalf("model.CloneAll() = %+v", all)
+	}
+	all[0].Changes[0].Path = "c.go"
+	if orig.Changes[0].Path != "a.go" || model.EqualAll(all, []git.Commit{orig, clone}) {
+		t.Error("model.CloneAll() shares slices with the original")
+	}
+	if model.CloneAll[git.Commit](nil) != nil {
+		t.Error("model.CloneAll(nil) != nil")
+	}
+}
+
+func TestCommit_Compare(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(hash string, d time.Duration) git.Commit {
+		return git.Commit{
+			Hash:      git.Hash(hash + strings.Repeat("0", 40-len(hash))),
+			Committer: git.Signature{Name: "J", Email: "j@example.com", When: base.Add(d)},
+		}
+	}
+	commits := []git.Commit{at("c", time.Hour), at("b", 0), at("a", time.Hour), at("d", -time.Hour)}
+	model.Sort(commits)
+
+	var got []string
+	for _, c := range commits {
+		got = append(got, string(c.Hash)[:1])
+	}
+	if want := "d b a c"; strings.Join(got, " ") != want {
+		t.Errorf("model.Sort() order = %v, want %s", got, want)
+	}
+
+	if n := at("a", 0).Compare(at("a", 0)); n != 0 {
+		t.Errorf("Compare(self) = %d, want 0", n)
+	}
+}
+
+func TestCommit_LogValue(t *testing.T) {
+	sig := git.Signature{Name: "Jane Doe", Email: "jane@example.com", When: time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)}
+	c := git.Commit{
+		Hash:      "a1b2c3d4e5f67890abcdef1234567890abcdef12",
+		Parents:   []git.Hash{"1234567890abcdef1234567890abcdef12345678"},
+		Author:    sig,
+		Committer: sig,
+		Message:   "feat: add x\n\nsecret body",
+		Summary:   "feat: add x",
+		Changes:   []git.FileChange{{Path: "a.go", Kind: git.FileChangeAdded}, {Path: "b.go", Kind: git.FileChangeModified}},
+	}
+
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})).Info("classified", "commit", c)
+
+	want := `level=INFO msg=classified commit.hash=a1b2c3d4e5f67890abcdef1234567890abcdef12 commit.short=a1b2c3d ` +
+		`commit.author.name="Jane Doe" commit.author.email=j***@example.com commit.author.when=2025-01-15T10:30:00.000Z ` +
+		`commit.summary="feat: add x" commit.parents=1 commit.changes=2` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("LogValue() logged\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCommit_RedactionPolicy(t *testing.T) {
+	c := git.Commit{
+		Hash:    git.Hash("a1b2c3d4e5f67890abcdef1234567890abcdef12"),
+		Author:  git.Signature{Name: "Jane Doe", Email: "jane@example.com"},
+		Summary: "fix: bug",
+	}
+	prev := model.SetRedactionPolicy(model.Redaction{Name: model.DropName})
+	defer model.SetRedactionPolicy(prev)
+
+	if got := c.String(); !strings.Contains(got, "Author:Jane Doe,") {
+		t.Errorf("String() = %q, want the unredacted author", got)
+	}
+	if got := c.Redacted(); !strings.Contains(got, "Author:,") {
+		t.Errorf("Redacted() = %q, want the author dropped", got)
+	}
+}
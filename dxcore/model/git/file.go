@@ -17,8 +17,10 @@
 package git
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"strings"
 
 	"dirpx.dev/dxrel/dxcore/errors"
@@ -274,6 +276,11 @@ func (k FileChangeKind) Redacted() string {
 	return k.String()
 }
 
+// LogValue implements slog.LogValuer, logging the FileChangeKind as its Redacted form.
+func (k FileChangeKind) LogValue() slog.Value {
+	return slog.StringValue(k.Redacted())
+}
+
 // TypeName returns the canonical name of this model type for debugging,
 // logging, and reflection purposes. This method satisfies the model.Identifiable
 // interface's TypeName requirement.
@@ -376,6 +383,7 @@ func (k FileChangeKind) Validate() error {
 		return nil
 	default:
 		return &errors.ValidationError{
+			Code:   errors.CodeOutOfRange,
 			Type:   k.TypeName(),
 			Field:  "",
 			Reason: fmt.Sprintf("invalid value: %d", uint8(k)),
@@ -611,6 +619,14 @@ type FileChange struct {
 // Compile-time check that FileChange implements model.Model
 var _ model.Model = (*FileChange)(nil)
 
+// Compile-time assertions that FileChange implements the optional model
+// interfaces.
+var (
+	_ model.Comparable[FileChange] = FileChange{}
+	_ model.Cloneable[FileChange]  = FileChange{}
+	_ model.Ordered[FileChange]    = FileChange{}
+)
+
 // NewFileChange creates a new FileChange with the given Path and Kind, validating
 // the result before returning.
 //
@@ -727,6 +743,17 @@ func (fc FileChange) Redacted() string {
 		fc.Path, fc.Kind.Redacted())
 }
 
+// LogValue implements slog.LogValuer, logging the FileChange as a group of
+// its path, its old path for renames and copies, and its kind.
+func (fc FileChange) LogValue() slog.Value {
+	attrs := []slog.Attr{slog.String("path", fc.Path)}
+	if fc.OldPath != "" {
+		attrs = append(attrs, slog.String("old_path", fc.OldPath))
+	}
+	attrs = append(attrs, slog.Any("kind", fc.Kind))
+	return slog.GroupValue(attrs...)
+}
+
 // TypeName returns the canonical name of this model type for debugging,
 // logging, and reflection purposes. This method satisfies the model.Identifiable
 // interface's TypeName requirement.
@@ -802,6 +829,26 @@ func (fc FileChange) Equal(other FileChange) bool {
 		fc.Kind.Equal(other.Kind)
 }
 
+// Clone returns a copy of the FileChange. FileChange holds only values, so
+// the copy shares nothing with the original.
+//
+// Clone implements model.Cloneable[FileChange].
+func (fc FileChange) Clone() FileChange {
+	return fc
+}
+
+// Compare orders file changes by Path, then OldPath, then Kind, which is a
+// total ordering consistent with Equal.
+//
+// Compare implements model.Ordered[FileChange].
+func (fc FileChange) Compare(other FileChange) int {
+	return cmp.Or(
+		strings.Compare(fc.Path, other.Path),
+		strings.Compare(fc.OldPath, other.OldPath),
+		cmp.Compare(fc.Kind, other.Kind),
+	)
+}
+
 // Validate checks whether this FileChange satisfies all model contracts and
 // invariants. This method implements the model.Validatable interface's Validate
 // requirement, enforcing data integrity for file change records.
@@ -846,70 +893,91 @@ func (fc FileChange) Equal(other FileChange) bool {
 //	err := fc.Validate()
 //	// err: "FileChange OldPath should only be set for renamed/copied files (got kind=modified)"
 func (fc FileChange) Validate() error {
-	// Validate Path
-	if fc.Path == "" {
-		return &errors.ValidationError{
-			Type:   fc.TypeName(),
-			Field:  "Path",
-			Reason: "must not be empty",
-		}
-	}
-	if len(fc.Path) > FilePathMaxLength {
-		return &errors.ValidationError{
-			Type:   fc.TypeName(),
-			Field:  "Path",
-			Reason: fmt.Sprintf("exceeds maximum length of %d characters (got %d)", FilePathMaxLength, len(fc.Path)),
-		}
-	}
-	if strings.HasPrefix(fc.Path, "/") {
-		return &errors.ValidationError{
-			Type:   fc.TypeName(),
-			Field:  "Path",
-			Reason: fmt.Sprintf("must be relative (no leading slash): %q", fc.Path),
-		}
-	}
+	return errors.RunChecks(false, fc.checks()...)
+}
 
-	// Validate Kind
-	if err := fc.Kind.Validate(); err != nil {
-		return &errors.ValidationError{
-			Type:   fc.TypeName(),
-			Field:  "Kind",
-			Reason: fmt.Sprintf("invalid: %v", err),
-		}
-	}
+// ValidateFull is like Validate, but instead of stopping at the first
+// problem it reports one error per invalid field, joined with errors.Join
+// (see errors.Violations).
+func (fc FileChange) ValidateFull() error {
+	return errors.RunChecks(true, fc.checks()...)
+}
 
-	// Validate OldPath consistency
-	if fc.OldPath != "" {
-		// OldPath should only be set for renames and copies
-		if fc.Kind != FileChangeRenamed && fc.Kind != FileChangeCopied {
-			return &errors.ValidationError{
-				Type:   fc.TypeName(),
-				Field:  "OldPath",
-				Reason: fmt.Sprintf("should only be set for renamed/copied files (got kind=%s)", fc.Kind.String()),
+// checks returns the validation steps of Validate, one per field.
+func (fc FileChange) checks() []errors.Check {
+	return []errors.Check{
+		// Validate Path
+		func() error {
+			if fc.Path == "" {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   fc.TypeName(),
+					Field:  "Path",
+					Reason: "must not be empty",
+				}
 			}
-		}
-
-		// Validate OldPath format
-		if len(fc.OldPath) > FilePathMaxLength {
-			return &errors.ValidationError{
-				Type:   fc.TypeName(),
-				Field:  "OldPath",
-				Reason: fmt.Sprintf("exceeds maximum length of %d characters (got %d)", FilePathMaxLength, len(fc.OldPath)),
+			if len(fc.Path) > FilePathMaxLength {
+				return &errors.ValidationError{
+					Code:   errors.CodeTooLong,
+					Type:   fc.TypeName(),
+					Field:  "Path",
+					Reason: fmt.Sprintf("exceeds maximum length of %d characters (got %d)", FilePathMaxLength, len(fc.Path)),
+				}
 			}
-		}
-		if strings.HasPrefix(fc.OldPath, "/") {
-			return &errors.ValidationError{
-				Type:   fc.TypeName(),
-				Field:  "OldPath",
-				Reason: fmt.Sprintf("must be relative (no leading slash): %q", fc.OldPath),
+			if strings.HasPrefix(fc.Path, "/") {
+				return &errors.ValidationError{
+					Code:   errors.CodeFormat,
+					Type:   fc.TypeName(),
+					Field:  "Path",
+					Reason: fmt.Sprintf("must be relative (no leading slash): %q", fc.Path),
+				}
 			}
-		}
-	} else {
-		// Warn if OldPath is missing for rename/copy (but don't fail - might be partial data)
-		// This is informational only, not a hard error
+			return nil
+		},
+		// Validate Kind
+		func() error {
+			if err := fc.Kind.Validate(); err != nil {
+				return errors.Nest(err, fc.TypeName(), "Kind")
+			}
+			return nil
+		},
+		// Validate OldPath consistency
+		func() error {
+			if fc.OldPath != "" {
+				// OldPath should only be set for renames and copies
+				if fc.Kind != FileChangeRenamed && fc.Kind != FileChangeCopied {
+					return &errors.ValidationError{
+						Code:   errors.CodeInconsistent,
+						Type:   fc.TypeName(),
+						Field:  "OldPath",
+						Reason: fmt.Sprintf("should only be set for renamed/copied files (got kind=%s)", fc.Kind.String()),
+					}
+				}
+
+				// Validate OldPath format
+				if len(fc.OldPath) > FilePathMaxLength {
+					return &errors.ValidationError{
+						Code:   errors.CodeTooLong,
+						Type:   fc.TypeName(),
+						Field:  "OldPath",
+						Reason: fmt.Sprintf("exceeds maximum length of %d characters (got %d)", FilePathMaxLength, len(fc.OldPath)),
+					}
+				}
+				if strings.HasPrefix(fc.OldPath, "/") {
+					return &errors.ValidationError{
+						Code:   errors.CodeFormat,
+						Type:   fc.TypeName(),
+						Field:  "OldPath",
+						Reason: fmt.Sprintf("must be relative (no leading slash): %q", fc.OldPath),
+					}
+				}
+			} else {
+				// Warn if OldPath is missing for rename/copy (but don't fail - might be partial data)
+				// This is informational only, not a hard error
+			}
+			return nil
+		},
 	}
-
-	return nil
 }
 
 // MarshalJSON implements json.Marshaler, serializing the FileChange to JSON
@@ -996,6 +1064,7 @@ func (fc *FileChange) UnmarshalJSON(data []byte) error {
 			Type:   fc.TypeName(),
 			Data:   data,
 			Reason: fmt.Sprintf("validation failed: %v", err),
+			Err:    err,
 		}
 	}
 
@@ -1081,6 +1150,7 @@ func (fc *FileChange) UnmarshalYAML(node *yaml.Node) error {
 			Type:   fc.TypeName(),
 			Data:   []byte(fmt.Sprintf("%v", node)),
 			Reason: fmt.Sprintf("validation failed: %v", err),
+			Err:    err,
 		}
 	}
 
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"testing"
 
+	"dirpx.dev/dxrel/dxcore/model"
 	"dirpx.dev/dxrel/dxcore/model/git"
 	"gopkg.in/yaml.v3"
 )
@@ -1004,3 +1005,28 @@ func TestFileChange_CommonScenarios(t *testing.T) {
 		})
 	}
 }
+
+func TestFileChange_Compare(t *testing.T) {
+	changes := []git.FileChange{
+		{Path: "b.go", Kind: git.FileChangeModified},
+		{Path: "a.go", Kind: git.FileChangeRenamed, OldPath: "z.go"},
+		{Path: "a.go", Kind: git.FileChangeModified},
+		{Path: "a.go", Kind: git.FileChangeAdded},
+	}
+	model.Sort(changes)
+
+	want := []git.FileChange{
+		{Path: "a.go", Kind: git.FileChangeAdded},
+		{Path: "a.go", Kind: git.FileChangeModified},
+		{Path: "a.go", Kind: git.FileChangeRenamed, OldPath: "z.go"},
+		{Path: "b.go", Kind: git.FileChangeModified},
+	}
+	for i := range want {
+		if !changes[i].Equal(want[i]) {
+			t.Errorf("model.Sort()[%d] = %+v, want %+v", i, changes[i], want[i])
+		}
+		if changes[i].Compare(want[i]) != 0 {
+			t.Errorf("Compare() of equal changes = %d, want 0", changes[i].Compare(want[i]))
+		}
+	}
+}
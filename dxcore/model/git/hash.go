@@ -17,11 +17,14 @@
 package git
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"regexp"
 	"strings"
 
+	"dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
 	"gopkg.in/yaml.v3"
 )
@@ -228,6 +231,13 @@ func (h Hash) Redacted() string {
 	return h.Short()
 }
 
+// LogValue implements slog.LogValuer, logging the full Hash so that log
+// entries can be matched against git output. Object ids are not sensitive;
+// Redacted abbreviates them only for brevity.
+func (h Hash) LogValue() slog.Value {
+	return slog.StringValue(h.String())
+}
+
 // TypeName returns the canonical name of this model type for debugging,
 // logging, and reflection purposes. This method satisfies the model.Identifiable
 // interface's TypeName requirement.
@@ -307,6 +317,14 @@ func (h Hash) Equal(other Hash) bool {
 	return h == other
 }
 
+// Compare orders hashes lexicographically. It is mainly used to break ties
+// when ordering commits.
+//
+// Compare implements model.Ordered[Hash].
+func (h Hash) Compare(other Hash) int {
+	return strings.Compare(string(h), string(other))
+}
+
 // Short returns an abbreviated form of the Hash suitable for display in
 // user interfaces, logs, and command-line output. The abbreviated hash
 // consists of the first HashShortLen (7) characters of the full object id,
@@ -439,12 +457,22 @@ func (h Hash) Validate() error {
 
 	// Check length
 	if len(str) != HashHexSizeSHA1 && len(str) != HashHexSizeSHA256 {
-		return fmt.Errorf("Hash %q has invalid length: %d (expected %d for SHA-1 or %d for SHA-256)", str, len(str), HashHexSizeSHA1, HashHexSizeSHA256)
+		return &errors.ValidationError{
+			Code:   errors.CodeFormat,
+			Type:   h.TypeName(),
+			Reason: fmt.Sprintf("Hash %q has invalid length: %d (expected %d for SHA-1 or %d for SHA-256)", str, len(str), HashHexSizeSHA1, HashHexSizeSHA256),
+			Value:  str,
+		}
 	}
 
 	// Check format (lowercase hexadecimal)
 	if !HashHexRegexp.MatchString(str) {
-		return fmt.Errorf("Hash %q contains invalid characters (must be lowercase hexadecimal [0-9a-f])", str)
+		return &errors.ValidationError{
+			Code:   errors.CodeFormat,
+			Type:   h.TypeName(),
+			Reason: fmt.Sprintf("Hash %q contains invalid characters (must be lowercase hexadecimal [0-9a-f])", str),
+			Value:  str,
+		}
 	}
 
 	return nil
@@ -595,5 +623,56 @@ func (h *Hash) UnmarshalYAML(node *yaml.Node) error {
 	return nil
 }
 
+// MarshalBinary implements encoding.BinaryMarshaler, returning the raw
+// digest (20 bytes for SHA-1, 32 for SHA-256) rather than its hexadecimal
+// text. The zero value marshals to an empty slice. Binary encodings such as
+// model.MarshalCBOR use it, which halves the encoded size of a Hash.
+//
+// Like MarshalJSON, MarshalBinary fails for hashes that do not validate.
+func (h Hash) MarshalBinary() ([]byte, error) {
+	if err := h.Validate(); err != nil {
+		return nil, fmt.Errorf("cannot marshal invalid %s: %w", h.TypeName(), err)
+	}
+	raw, err := hex.DecodeString(string(h))
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal invalid %s: %w", h.TypeName(), err)
+	}
+	return raw, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, parsing a raw
+// digest produced by MarshalBinary back into its lowercase hexadecimal form.
+// Digests of any length other than HashByteSizeSHA1 or HashByteSizeSHA256
+// are rejected; an empty slice yields the zero value.
+func (h *Hash) UnmarshalBinary(data []byte) error {
+	parsed, err := ParseHash(hex.EncodeToString(data))
+	if err != nil {
+		return fmt.Errorf("unmarshaled model is invalid: %w", err)
+	}
+	*h = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the hexadecimal
+// form of the Hash. It fails for hashes that do not validate.
+func (h Hash) MarshalText() ([]byte, error) {
+	if err := h.Validate(); err != nil {
+		return nil, fmt.Errorf("cannot marshal invalid %s: %w", h.TypeName(), err)
+	}
+	return []byte(h), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing hexadecimal
+// text with ParseHash. Text encodings that do not use MarshalJSON or
+// MarshalYAML, and binary encodings receiving the hash as text, use it.
+func (h *Hash) UnmarshalText(text []byte) error {
+	parsed, err := ParseHash(string(text))
+	if err != nil {
+		return fmt.Errorf("unmarshaled model is invalid: %w", err)
+	}
+	*h = parsed
+	return nil
+}
+
 // Compile-time verification that Hash implements model.Model interface.
 var _ model.Model = (*Hash)(nil)
@@ -438,6 +438,65 @@ func TestHash_YAML_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestHash_Binary(t *testing.T) {
+	tests := []struct {
+		name    string
+		hash    git.Hash
+		wantLen int
+	}{
+		{"empty", git.Hash(""), 0},
+		{"sha1", git.Hash("a1b2c3d4e5f6789012345678901234567890abcd"), git.HashByteSizeSHA1},
+		{"sha256", git.Hash(strings.Repeat("ab", 32)), git.HashByteSizeSHA256},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.hash.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() error = %v", err)
+			}
+			if len(data) != tt.wantLen {
+				t.Errorf("MarshalBinary() length = %d, want %d", len(data), tt.wantLen)
+			}
+			var got git.Hash
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() error = %v", err)
+			}
+			if got != tt.hash {
+				t.Errorf("UnmarshalBinary() = %q, want %q", got, tt.hash)
+			}
+		})
+	}
+
+	if _, err := git.Hash("invalid").MarshalBinary(); err == nil {
+		t.Error("MarshalBinary() of invalid hash succeeded, want error")
+	}
+	var got git.Hash
+	if err := got.UnmarshalBinary([]byte{1, 2, 3}); err == nil {
+		t.Error("UnmarshalBinary() of short digest succeeded, want error")
+	}
+}
+
+func TestHash_Text(t *testing.T) {
+	var got git.Hash
+	if err := got.UnmarshalText([]byte("A1B2C3D4E5F6789012345678901234567890ABCD")); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if got != "a1b2c3d4e5f6789012345678901234567890abcd" {
+		t.Errorf("UnmarshalText() = %q", got)
+	}
+	if text, err := got.MarshalText(); err != nil || string(text) != string(got) {
+		t.Errorf("MarshalText() = %q, %v", text, err)
+	}
+
+	if err := got.UnmarshalText([]byte("xyz")); err == nil {
+		t.Error("UnmarshalText() of invalid hash succeeded, want error")
+	}
+	if _, err := git.Hash("invalid").MarshalText(); err == nil {
+		t.Error("MarshalText() of invalid hash succeeded, want error")
+	}
+}
+
 func TestHash_CaseNormalization(t *testing.T) {
 	// Test that uppercase input is normalized to lowercase
 	input := "A1B2C3D4E5F6789012345678901234567890ABCD"
@@ -0,0 +1,170 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package git
+
+import "strings"
+
+// Mailmap maps the identities recorded in commits to canonical ones, as
+// described by a .mailmap file (see gitmailmap(5)). People who committed
+// under several names or addresses are thereby counted, credited and
+// redacted as one person.
+//
+// The zero value is an empty Mailmap that maps every identity to itself. A
+// Mailmap is immutable after ParseMailmap returns and safe for concurrent
+// use.
+type Mailmap struct {
+	entries map[mailmapKey]mailmapEntry
+}
+
+// mailmapKey identifies the commit identity an entry applies to. Both
+// fields are lowercased; name is empty for entries that match on the email
+// address alone.
+type mailmapKey struct {
+	email string
+	name  string
+}
+
+// mailmapEntry is the canonical identity of an entry. An empty field keeps
+// the corresponding part of the commit identity.
+type mailmapEntry struct {
+	name  string
+	email string
+}
+
+// ParseMailmap parses the contents of a .mailmap file. Each line takes one
+// of the four forms git supports:
+//
+//	Proper Name <commit@email>
+//	<proper@email> <commit@email>
+//	Proper Name <proper@email> <commit@email>
+//	Proper Name <proper@email> Commit Name <commit@email>
+//
+// The first three forms apply to every commit with the given email address,
+// the last only to commits that also carry the given name. Email addresses
+// and names are matched case-insensitively. When several lines map the same
+// identity, the last one wins for each of the name and the address.
+//
+// ParseMailmap reads the file as git does: a line starting with "#" is a
+// comment, but a "#" elsewhere is part of the name; text after the last
+// email address is ignored; and lines that are in none of the forms are
+// skipped. Their line numbers are returned in skipped, so that callers can
+// warn about them; git ignores them silently.
+func ParseMailmap(data string) (m Mailmap, skipped []int) {
+	m = Mailmap{entries: map[mailmapKey]mailmapEntry{}}
+	for i, line := range strings.Split(data, "\n") {
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, entry, ok := parseMailmapLine(line)
+		if !ok {
+			skipped = append(skipped, i+1)
+			continue
+		}
+		if entry == (mailmapEntry{}) {
+			continue
+		}
+		prev := m.entries[key]
+		if entry.name == "" {
+			entry.name = prev.name
+		}
+		if entry.email == "" {
+			entry.email = prev.email
+		}
+		m.entries[key] = entry
+	}
+	return m, skipped
+}
+
+// parseMailmapLine parses one non-blank, non-comment line of a .mailmap
+// file. It reports false for lines without a non-empty first email address,
+// which git skips.
+func parseMailmapLine(line string) (mailmapKey, mailmapEntry, bool) {
+	properName, properEmail, rest, ok := cutMailmapIdentity(line)
+	if !ok || properEmail == "" {
+		return mailmapKey{}, mailmapEntry{}, false
+	}
+	commitName, commitEmail, _, ok := cutMailmapIdentity(rest)
+	if !ok {
+		// Proper Name <commit@email>
+		return mailmapKey{email: strings.ToLower(properEmail)}, mailmapEntry{name: properName}, true
+	}
+	// [Proper Name] <proper@email> [Commit Name] <commit@email>
+	key := mailmapKey{email: strings.ToLower(commitEmail), name: strings.ToLower(commitName)}
+	return key, mailmapEntry{name: properName, email: properEmail}, true
+}
+
+// cutMailmapIdentity parses the "Name <email>" at the start of s and returns
+// the trimmed name, the email address between the angle brackets as is, and
+// the text after the closing bracket.
+func cutMailmapIdentity(s string) (name, email, rest string, ok bool) {
+	open := strings.IndexByte(s, '<')
+	if open < 0 {
+		return "", "", "", false
+	}
+	end := strings.IndexByte(s[open+1:], '>')
+	if end < 0 {
+		return "", "", "", false
+	}
+	end += open + 1
+	return strings.TrimSpace(s[:open]), s[open+1 : end], s[end+1:], true
+}
+
+// Len returns the number of identities m maps.
+func (m Mailmap) Len() int {
+	return len(m.entries)
+}
+
+// Lookup returns the canonical name and email address for the identity
+// recorded as name and email. An entry matching both the name and the
+// address takes precedence over one matching the address alone; parts the
+// matching entry does not map, and identities without an entry, are
+// returned unchanged.
+func (m Mailmap) Lookup(name, email string) (string, string) {
+	key := mailmapKey{email: strings.ToLower(email), name: strings.ToLower(name)}
+	entry, ok := m.entries[key]
+	if !ok {
+		key.name = ""
+		entry, ok = m.entries[key]
+	}
+	if !ok {
+		return name, email
+	}
+	if entry.name != "" {
+		name = entry.name
+	}
+	if entry.email != "" {
+		email = entry.email
+	}
+	return name, email
+}
+
+// Resolve returns s with its name and email address replaced by the
+// canonical identity from m (see Lookup). The timestamp is kept.
+func (m Mailmap) Resolve(s Signature) Signature {
+	s.Name, s.Email = m.Lookup(s.Name, s.Email)
+	return s
+}
+
+// ResolveCommit returns c with its author and committer canonicalized with
+// Resolve. It is intended for commit readers and contributor reports that
+// honour the repository's .mailmap; c itself is not modified.
+func (m Mailmap) ResolveCommit(c Commit) Commit {
+	c = c.Clone()
+	c.Author = m.Resolve(c.Author)
+	c.Committer = m.Resolve(c.Committer)
+	return c
+}
@@ -0,0 +1,146 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package git_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+const testMailmap = `# Canonical identities
+Jane Doe <jane@example.com>
+<joe@example.com> <joe@old.example.com>
+Ann Smith <ann@example.com> <ann@laptop.local>   # trailing comment
+Bob Jones <bob@example.com> bob <BOB@shared.example.com>
+Robert Jones <robert@example.com> Robert <bob@shared.example.com>
+
+Jane Doe <jane@example.com> <JANE@Work.Example.com>
+`
+
+func TestMailmap_Lookup(t *testing.T) {
+	m, skipped := git.ParseMailmap(testMailmap)
+	if skipped != nil {
+		t.Fatalf("ParseMailmap() skipped lines %v", skipped)
+	}
+	if m.Len() != 6 {
+		t.Errorf("Len() = %d, want 6", m.Len())
+	}
+
+	tests := []struct {
+		name, inName, inEmail, wantName, wantEmail string
+	}{
+		{"name_only", "jane", "jane@example.com", "Jane Doe", "jane@example.com"},
+		{"email_only", "Joe", "joe@old.example.com", "Joe", "joe@example.com"},
+		{"name_and_email", "ann", "ann@laptop.local", "Ann Smith", "ann@example.com"},
+		{"by_name_and_email", "Bob", "bob@shared.example.com", "Bob Jones", "bob@example.com"},
+		{"by_name_and_email_other_name", "robert", "bob@shared.example.com", "Robert Jones", "robert@example.com"},
+		{"by_name_and_email_unknown_name", "Someone", "bob@shared.example.com", "Someone", "bob@shared.example.com"},
+		{"case_insensitive_email", "J", "jane@work.example.com", "Jane Doe", "jane@example.com"},
+		{"unmapped", "Eve", "eve@example.com", "Eve", "eve@example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, email := m.Lookup(tt.inName, tt.inEmail)
+			if name != tt.wantName || email != tt.wantEmail {
+				t.Errorf("Lookup(%q, %q) = %q, %q, want %q, %q",
+					tt.inName, tt.inEmail, name, email, tt.wantName, tt.wantEmail)
+			}
+		})
+	}
+}
+
+func TestMailmap_LaterLinesMerge(t *testing.T) {
+	m, _ := git.ParseMailmap("Jane Doe <old@example.com>\n<jane@example.com> <old@example.com>\n")
+	if name, email := m.Lookup("jd", "old@example.com"); name != "Jane Doe" || email != "jane@example.com" {
+		t.Errorf("Lookup() = %q, %q, want merged entry", name, email)
+	}
+}
+
+// TestParseMailmap_GitCompatible checks lines whose handling was compared
+// with "git check-mailmap".
+func TestParseMailmap_GitCompatible(t *testing.T) {
+	m, skipped := git.ParseMailmap(`C# Team <team@x.org>
+Proper <p@x.org> <c@x.org> trailing junk
+just a name
+Single <s@x.org> junk here
+<a@x.org> <b@x.org> <c2@x.org>
+ # indented comment <ic@x.org>
+# comment <cm@x.org>
+Unterminated <u@x.org
+  Spaced   <  sp@x.org >
+Empty <> <e@x.org>
+Jane <j@x.org> <>
+<n@x.org>
+`)
+	if want := []int{3, 8, 10}; fmt.Sprint(skipped) != fmt.Sprint(want) {
+		t.Errorf("ParseMailmap() skipped lines %v, want %v", skipped, want)
+	}
+
+	tests := []struct {
+		inEmail, wantName, wantEmail string
+	}{
+		{"team@x.org", "C# Team", "team@x.org"},
+		{"c@x.org", "Proper", "p@x.org"},
+		{"s@x.org", "Single", "s@x.org"},
+		{"b@x.org", "X", "a@x.org"},
+		{"ic@x.org", "# indented comment", "ic@x.org"},
+		{"cm@x.org", "X", "cm@x.org"},
+		{"u@x.org", "X", "u@x.org"},
+		{"sp@x.org", "X", "sp@x.org"},
+		{"  sp@x.org ", "Spaced", "  sp@x.org "},
+		{"e@x.org", "X", "e@x.org"},
+		{"", "Jane", "j@x.org"},
+		{"n@x.org", "X", "n@x.org"},
+	}
+	for _, tt := range tests {
+		name, email := m.Lookup("X", tt.inEmail)
+		if name != tt.wantName || email != tt.wantEmail {
+			t.Errorf("Lookup(X, %q) = %q, %q, want %q, %q", tt.inEmail, name, email, tt.wantName, tt.wantEmail)
+		}
+	}
+}
+
+func TestMailmap_ResolveCommit(t *testing.T) {
+	m, _ := git.ParseMailmap(testMailmap)
+	when := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	c := git.Commit{
+		Hash:      "a1b2c3d4e5f67890abcdef1234567890abcdef12",
+		Author:    git.Signature{Name: "ann", Email: "ann@laptop.local", When: when},
+		Committer: git.Signature{Name: "Joe", Email: "joe@old.example.com", When: when},
+		Message:   "fix: x",
+		Summary:   "fix: x",
+	}
+
+	got := m.ResolveCommit(c)
+	if want := (git.Signature{Name: "Ann Smith", Email: "ann@example.com", When: when}); !got.Author.Equal(want) {
+		t.Errorf("Author = %+v, want %+v", got.Author, want)
+	}
+	if got.Committer.Email != "joe@example.com" {
+		t.Errorf("Committer.Email = %q, want joe@example.com", got.Committer.Email)
+	}
+	if c.Author.Name != "ann" {
+		t.Error("ResolveCommit() modified its argument")
+	}
+
+	var zero git.Mailmap
+	if got := zero.Resolve(c.Author); !got.Equal(c.Author) {
+		t.Errorf("zero Mailmap Resolve() = %+v, want unchanged", got)
+	}
+}
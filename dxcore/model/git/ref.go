@@ -19,7 +19,9 @@ package git
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 
+	"dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
 	"gopkg.in/yaml.v3"
 )
@@ -186,6 +188,16 @@ func (r Ref) Redacted() string {
 		r.Hash.Redacted())
 }
 
+// LogValue implements slog.LogValuer, logging the Ref as a group of its
+// name, kind and hash.
+func (r Ref) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("name", r.Name),
+		slog.Any("kind", r.Kind),
+		slog.Any("hash", r.Hash),
+	)
+}
+
 // TypeName returns the name of this type for error messages and debugging.
 //
 // This method implements the model.Identifiable contract.
@@ -235,6 +247,14 @@ func (r Ref) Equal(other Ref) bool {
 		r.Hash.Equal(other.Hash)
 }
 
+// Clone returns a copy of the Ref. Ref holds only values, so the copy
+// shares nothing with the original.
+//
+// Clone implements model.Cloneable[Ref].
+func (r Ref) Clone() Ref {
+	return r
+}
+
 // Validate checks whether this Ref satisfies all model contracts and
 // invariants.
 //
@@ -275,42 +295,74 @@ func (r Ref) Equal(other Ref) bool {
 //	Ref{Name: "refs/heads/main", Kind: RefKindTag, Hash: "a1b2c3d..."}.Validate()
 //	// Returns: error "Kind mismatch: Name implies branch but got tag"
 func (r Ref) Validate() error {
-	// At least Name or Hash must be non-zero for a meaningful Ref
-	if r.Name.IsZero() && r.Hash.IsZero() {
-		return fmt.Errorf("%s must have at least Name or Hash", r.TypeName())
-	}
-
-	// Validate Name if present
-	if !r.Name.IsZero() {
-		if err := r.Name.Validate(); err != nil {
-			return fmt.Errorf("invalid %s Name: %w", r.TypeName(), err)
-		}
-	}
-
-	// Validate Kind if present
-	if !r.Kind.IsZero() {
-		if err := r.Kind.Validate(); err != nil {
-			return fmt.Errorf("invalid %s Kind: %w", r.TypeName(), err)
-		}
-	}
+	return errors.RunChecks(false, r.checks()...)
+}
 
-	// Validate Hash if present
-	if !r.Hash.IsZero() {
-		if err := r.Hash.Validate(); err != nil {
-			return fmt.Errorf("invalid %s Hash: %w", r.TypeName(), err)
-		}
-	}
+// ValidateFull is like Validate, but instead of stopping at the first
+// problem it reports one error per invalid field, joined with errors.Join
+// (see errors.Violations).
+func (r Ref) ValidateFull() error {
+	return errors.RunChecks(true, r.checks()...)
+}
 
-	// Cross-validate Kind and Name consistency if both are present and non-zero
-	if !r.Name.IsZero() && !r.Kind.IsZero() && r.Kind != RefKindUnknown {
-		expectedKind := inferRefKindFromName(r.Name)
-		if expectedKind != RefKindUnknown && expectedKind != r.Kind {
-			return fmt.Errorf("%s Kind mismatch: Name %q implies Kind %q but got %q",
-				r.TypeName(), r.Name, expectedKind.String(), r.Kind.String())
-		}
+// checks returns the validation steps of Validate, one per field.
+func (r Ref) checks() []errors.Check {
+	return []errors.Check{
+		// At least Name or Hash must be non-zero for a meaningful Ref
+		func() error {
+			if r.Name.IsZero() && r.Hash.IsZero() {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   r.TypeName(),
+					Reason: fmt.Sprintf("%s must have at least Name or Hash", r.TypeName()),
+				}
+			}
+			return nil
+		},
+		// Validate Name if present
+		func() error {
+			if !r.Name.IsZero() {
+				if err := r.Name.Validate(); err != nil {
+					return errors.Nest(err, r.TypeName(), "Name")
+				}
+			}
+			return nil
+		},
+		// Validate Kind if present
+		func() error {
+			if !r.Kind.IsZero() {
+				if err := r.Kind.Validate(); err != nil {
+					return errors.Nest(err, r.TypeName(), "Kind")
+				}
+			}
+			return nil
+		},
+		// Validate Hash if present
+		func() error {
+			if !r.Hash.IsZero() {
+				if err := r.Hash.Validate(); err != nil {
+					return errors.Nest(err, r.TypeName(), "Hash")
+				}
+			}
+			return nil
+		},
+		// Cross-validate Kind and Name consistency if both are present and non-zero
+		func() error {
+			if !r.Name.IsZero() && !r.Kind.IsZero() && r.Kind != RefKindUnknown {
+				expectedKind := inferRefKindFromName(r.Name)
+				if expectedKind != RefKindUnknown && expectedKind != r.Kind {
+					return &errors.ValidationError{
+						Code:   errors.CodeInconsistent,
+						Type:   r.TypeName(),
+						Field:  "Kind",
+						Reason: fmt.Sprintf("%s Kind mismatch: Name %q implies Kind %q but got %q", r.TypeName(), r.Name, expectedKind.String(), r.Kind.String()),
+						Value:  r.Kind.String(),
+					}
+				}
+			}
+			return nil
+		},
 	}
-
-	return nil
 }
 
 // inferRefKindFromName determines the expected RefKind based on the structure
@@ -19,8 +19,10 @@ package git
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"strings"
 
+	"dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
 	"gopkg.in/yaml.v3"
 )
@@ -225,6 +227,11 @@ func (rk RefKind) Redacted() string {
 	return rk.String()
 }
 
+// LogValue implements slog.LogValuer, logging the RefKind as its Redacted form.
+func (rk RefKind) LogValue() slog.Value {
+	return slog.StringValue(rk.Redacted())
+}
+
 // TypeName returns the name of this type for error messages, logging,
 // and debugging.
 //
@@ -291,7 +298,12 @@ func (rk RefKind) Validate() error {
 	case RefKindUnknown, RefKindBranch, RefKindRemoteBranch, RefKindTag, RefKindHead, RefKindHash:
 		return nil
 	default:
-		return fmt.Errorf("RefKind value %d is not a known kind (valid range: 0-%d)", uint8(rk), uint8(RefKindHash))
+		return &errors.ValidationError{
+			Code:   errors.CodeOutOfRange,
+			Type:   rk.TypeName(),
+			Reason: fmt.Sprintf("RefKind value %d is not a known kind (valid range: 0-%d)", uint8(rk), uint8(RefKindHash)),
+			Value:  int(rk),
+		}
 	}
 }
 
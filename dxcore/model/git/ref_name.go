@@ -19,10 +19,12 @@ package git
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"regexp"
 	"strings"
 	"unicode"
 
+	"dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
 	"gopkg.in/yaml.v3"
 )
@@ -195,6 +197,11 @@ func (rn RefName) Redacted() string {
 	return string(rn)
 }
 
+// LogValue implements slog.LogValuer, logging the RefName as its Redacted form.
+func (rn RefName) LogValue() slog.Value {
+	return slog.StringValue(rn.Redacted())
+}
+
 // TypeName returns the name of this type for error messages, logging,
 // and debugging.
 //
@@ -284,30 +291,60 @@ func (rn RefName) Validate() error {
 
 	// Check for leading/trailing whitespace (should have been normalized)
 	if strings.TrimSpace(str) != str {
-		return fmt.Errorf("RefName %q contains leading or trailing whitespace", str)
+		return &errors.ValidationError{
+			Code:   errors.CodeFormat,
+			Type:   rn.TypeName(),
+			Reason: fmt.Sprintf("RefName %q contains leading or trailing whitespace", str),
+			Value:  str,
+		}
 	}
 
 	// Validate length constraints
 	runeCount := len([]rune(str))
 	if runeCount < RefNameMinLen {
-		return fmt.Errorf("RefName %q is too short: %d runes (minimum %d)", str, runeCount, RefNameMinLen)
+		return &errors.ValidationError{
+			Code:   errors.CodeTooShort,
+			Type:   rn.TypeName(),
+			Reason: fmt.Sprintf("RefName %q is too short: %d runes (minimum %d)", str, runeCount, RefNameMinLen),
+			Value:  str,
+		}
 	}
 	if runeCount > RefNameMaxLen {
-		return fmt.Errorf("RefName %q is too long: %d runes (maximum %d)", str, runeCount, RefNameMaxLen)
+		return &errors.ValidationError{
+			Code:   errors.CodeTooLong,
+			Type:   rn.TypeName(),
+			Reason: fmt.Sprintf("RefName %q is too long: %d runes (maximum %d)", str, runeCount, RefNameMaxLen),
+			Value:  str,
+		}
 	}
 
 	// Validate character set
 	if !RefNameRegexp.MatchString(str) {
-		return fmt.Errorf("RefName %q contains invalid characters (must match pattern %s)", str, refNamePattern)
+		return &errors.ValidationError{
+			Code:   errors.CodeFormat,
+			Type:   rn.TypeName(),
+			Reason: fmt.Sprintf("RefName %q contains invalid characters (must match pattern %s)", str, refNamePattern),
+			Value:  str,
+		}
 	}
 
 	// Check for ASCII control characters and other problematic chars
 	for _, r := range str {
 		if unicode.IsControl(r) {
-			return fmt.Errorf("RefName %q contains control character (U+%04X)", str, r)
+			return &errors.ValidationError{
+				Code:   errors.CodeFormat,
+				Type:   rn.TypeName(),
+				Reason: fmt.Sprintf("RefName %q contains control character (U+%04X)", str, r),
+				Value:  str,
+			}
 		}
 		if r > unicode.MaxASCII {
-			return fmt.Errorf("RefName %q contains non-ASCII character %q (U+%04X)", str, r, r)
+			return &errors.ValidationError{
+				Code:   errors.CodeFormat,
+				Type:   rn.TypeName(),
+				Reason: fmt.Sprintf("RefName %q contains non-ASCII character %q (U+%04X)", str, r, r),
+				Value:  str,
+			}
 		}
 	}
 
@@ -17,8 +17,10 @@
 package git
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/mail"
 	"strings"
 	"time"
@@ -149,6 +151,14 @@ type Signature struct {
 // Compile-time check that Signature implements model.Model
 var _ model.Model = (*Signature)(nil)
 
+// Compile-time assertions that Signature implements the optional model
+// interfaces.
+var (
+	_ model.Comparable[Signature] = Signature{}
+	_ model.Cloneable[Signature]  = Signature{}
+	_ model.Ordered[Signature]    = Signature{}
+)
+
 // NewSignature creates a new Signature with the given Name, Email, and When,
 // validating the result before returning.
 //
@@ -217,19 +227,17 @@ func (s Signature) String() string {
 // production logging.
 //
 // This method implements the model.Loggable contract's Redacted() requirement.
-// The email address is partially redacted to protect privacy in production
-// logs while maintaining enough information for debugging.
-//
-// Email redaction pattern:
-//   - Shows first character + *** + @ + domain
+// The email address and the name are passed through the current
+// model.RedactionPolicy, which by default masks the local part of the email
+// (model.MaskEmail) and keeps the name:
 //   - Example: "jane@example.com" -> "j***@example.com"
-//   - Empty or invalid emails shown as "[invalid]"
+//   - Empty or invalid emails shown as "[empty]" or "[invalid]"
 //
-// Name and When are not redacted as they are not considered sensitive.
+// When is not redacted as it is not considered sensitive.
 //
 // Format: "Signature{Name:<name>, Email:<redacted-email>, When:<timestamp>}"
 //
-// Examples:
+// Examples (with model.DefaultRedaction):
 //
 //	Signature{Name: "Jane Doe", Email: "jane@example.com", When: <time>}.Redacted()
 //	// Output: "Signature{Name:Jane Doe, Email:j***@example.com, When:2025-01-15T10:30:00Z}"
@@ -237,55 +245,20 @@ func (s Signature) String() string {
 //	Signature{Name: "John", Email: "a@b.c", When: <time>}.Redacted()
 //	// Output: "Signature{Name:John, Email:a***@b.c, When:2025-01-15T10:30:00Z}"
 func (s Signature) Redacted() string {
-	redactedEmail := redactEmail(s.Email)
 	return fmt.Sprintf("Signature{Name:%s, Email:%s, When:%s}",
-		s.Name,
-		redactedEmail,
+		model.RedactName(s.Name),
+		model.RedactEmail(s.Email),
 		s.When.Format(time.RFC3339))
 }
 
-// redactEmail redacts an email address for safe logging in production environments,
-// applying a privacy-preserving transformation that obscures the local part while
-// preserving the domain for debugging purposes.
-//
-// Redaction pattern: first_char + "***" + "@" + domain
-//
-// This function implements a consistent redaction strategy:
-//   - If email is empty, returns "[empty]"
-//   - If email has no "@" or "@" is at position 0, returns "[invalid]"
-//   - Otherwise, returns: first character of local part + "***" + "@" + domain
-//
-// Examples:
-//   - "jane@example.com" -> "j***@example.com"
-//   - "a@b.c" -> "a***@b.c"
-//   - "" -> "[empty]"
-//   - "invalid" -> "[invalid]"
-//   - "@example.com" -> "[invalid]"
-//
-// This redaction strikes a balance between privacy and debuggability. The domain
-// is preserved to help identify organizational affiliations or email providers
-// without exposing the full user identity.
-//
-// This function is pure, has no side effects, and is safe for concurrent use.
-func redactEmail(email string) string {
-	if email == "" {
-		return "[empty]"
-	}
-
-	atIndex := strings.Index(email, "@")
-	if atIndex <= 0 {
-		return "[invalid]"
-	}
-
-	localPart := email[:atIndex]
-	domain := email[atIndex:]
-
-	if len(localPart) == 0 {
-		return "[invalid]"
-	}
-
-	// Show first character + *** + @domain
-	return string(localPart[0]) + "***" + domain
+// LogValue implements slog.LogValuer, logging the Signature as a group of
+// its name and email, redacted as in Redacted, and its timestamp.
+func (s Signature) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", model.RedactName(s.Name)),
+		slog.String("email", model.RedactEmail(s.Email)),
+		slog.Time("when", s.When),
+	)
 }
 
 // TypeName returns the name of this type for error messages and debugging.
@@ -337,6 +310,25 @@ func (s Signature) Equal(other Signature) bool {
 		s.When.Equal(other.When)
 }
 
+// Clone returns a copy of the Signature. Signature holds only values, so
+// the copy shares nothing with the original.
+//
+// Clone implements model.Cloneable[Signature].
+func (s Signature) Clone() Signature {
+	return s
+}
+
+// Compare orders signatures by When, then Name, then Email.
+//
+// Compare implements model.Ordered[Signature].
+func (s Signature) Compare(other Signature) int {
+	return cmp.Or(
+		s.When.Compare(other.When),
+		strings.Compare(s.Name, other.Name),
+		strings.Compare(s.Email, other.Email),
+	)
+}
+
 // Validate checks whether this Signature satisfies all model contracts and
 // invariants. This method implements the model.Validatable interface's Validate
 // requirement, enforcing data integrity for Git identity information.
@@ -386,56 +378,81 @@ func (s Signature) Equal(other Signature) bool {
 //	err := sig.Validate()
 //	// err: "Signature Name must not be empty"
 func (s Signature) Validate() error {
-	// Validate Name
-	if s.Name == "" {
-		return &errors.ValidationError{
-			Type:   s.TypeName(),
-			Field:  "Name",
-			Reason: "must not be empty",
-		}
-	}
-	if len(s.Name) > SignatureNameMaxLength {
-		return &errors.ValidationError{
-			Type:   s.TypeName(),
-			Field:  "Name",
-			Reason: fmt.Sprintf("exceeds maximum length of %d characters (got %d)", SignatureNameMaxLength, len(s.Name)),
-		}
-	}
+	return errors.RunChecks(false, s.checks()...)
+}
 
-	// Validate Email
-	if s.Email == "" {
-		return &errors.ValidationError{
-			Type:   s.TypeName(),
-			Field:  "Email",
-			Reason: "must not be empty",
-		}
-	}
-	if len(s.Email) > SignatureEmailMaxLength {
-		return &errors.ValidationError{
-			Type:   s.TypeName(),
-			Field:  "Email",
-			Reason: fmt.Sprintf("exceeds maximum length of %d characters (got %d)", SignatureEmailMaxLength, len(s.Email)),
-		}
-	}
-	// Use standard library to validate email format per RFC 5322
-	if _, err := mail.ParseAddress(s.Email); err != nil {
-		return &errors.ValidationError{
-			Type:   s.TypeName(),
-			Field:  "Email",
-			Reason: fmt.Sprintf("has invalid format: %q (%v)", s.Email, err),
-		}
-	}
+// ValidateFull is like Validate, but instead of stopping at the first
+// problem it reports one error per invalid field, joined with errors.Join
+// (see errors.Violations).
+func (s Signature) ValidateFull() error {
+	return errors.RunChecks(true, s.checks()...)
+}
 
-	// Validate When
-	if s.When.IsZero() {
-		return &errors.ValidationError{
-			Type:   s.TypeName(),
-			Field:  "When",
-			Reason: "must not be zero",
-		}
+// checks returns the validation steps of Validate, one per field.
+func (s Signature) checks() []errors.Check {
+	return []errors.Check{
+		// Validate Name
+		func() error {
+			if s.Name == "" {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   s.TypeName(),
+					Field:  "Name",
+					Reason: "must not be empty",
+				}
+			}
+			if len(s.Name) > SignatureNameMaxLength {
+				return &errors.ValidationError{
+					Code:   errors.CodeTooLong,
+					Type:   s.TypeName(),
+					Field:  "Name",
+					Reason: fmt.Sprintf("exceeds maximum length of %d characters (got %d)", SignatureNameMaxLength, len(s.Name)),
+				}
+			}
+			return nil
+		},
+		// Validate Email
+		func() error {
+			if s.Email == "" {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   s.TypeName(),
+					Field:  "Email",
+					Reason: "must not be empty",
+				}
+			}
+			if len(s.Email) > SignatureEmailMaxLength {
+				return &errors.ValidationError{
+					Code:   errors.CodeTooLong,
+					Type:   s.TypeName(),
+					Field:  "Email",
+					Reason: fmt.Sprintf("exceeds maximum length of %d characters (got %d)", SignatureEmailMaxLength, len(s.Email)),
+				}
+			}
+			// Use standard library to validate email format per RFC 5322
+			if _, err := mail.ParseAddress(s.Email); err != nil {
+				return &errors.ValidationError{
+					Code:   errors.CodeFormat,
+					Type:   s.TypeName(),
+					Field:  "Email",
+					Reason: fmt.Sprintf("has invalid format: %q (%v)", s.Email, err),
+				}
+			}
+			return nil
+		},
+		// Validate When
+		func() error {
+			if s.When.IsZero() {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   s.TypeName(),
+					Field:  "When",
+					Reason: "must not be zero",
+				}
+			}
+			return nil
+		},
 	}
-
-	return nil
 }
 
 // MarshalJSON implements json.Marshaler, serializing the Signature to JSON
@@ -525,6 +542,7 @@ func (s *Signature) UnmarshalJSON(data []byte) error {
 			Type:   s.TypeName(),
 			Data:   data,
 			Reason: fmt.Sprintf("validation failed: %v", err),
+			Err:    err,
 		}
 	}
 
@@ -614,6 +632,7 @@ func (s *Signature) UnmarshalYAML(node *yaml.Node) error {
 			Type:   s.TypeName(),
 			Data:   []byte(fmt.Sprintf("%v", node)),
 			Reason: fmt.Sprintf("validation failed: %v", err),
+			Err:    err,
 		}
 	}
 
@@ -17,12 +17,15 @@
 package git_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"strings"
 	"testing"
 	"time"
 
+	"dirpx.dev/dxrel/dxcore/model"
 	"dirpx.dev/dxrel/dxcore/model/git"
 	"gopkg.in/yaml.v3"
 )
@@ -122,6 +125,28 @@ func TestSignature_Redacted(t *testing.T) {
 	}
 }
 
+func TestSignature_RedactionPolicy(t *testing.T) {
+	sig := git.Signature{Name: "Jane Doe", Email: "jane@example.com", When: time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)}
+
+	prev := model.SetRedactionPolicy(model.Redaction{Email: model.EmailDomain, Name: model.DropName})
+	defer model.SetRedactionPolicy(prev)
+
+	if got, want := sig.Redacted(), "Signature{Name:, Email:@example.com, When:2025-01-15T10:30:00Z}"; got != want {
+		t.Errorf("Redacted() = %q, want %q", got, want)
+	}
+
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("signed", "author", sig)
+	if out := buf.String(); !strings.Contains(out, "author.email=@example.com") || strings.Contains(out, "Jane") {
+		t.Errorf("LogValue() logged %s", out)
+	}
+
+	model.SetRedactionPolicy(model.NoRedaction)
+	if got := sig.Redacted(); !strings.Contains(got, "Name:Jane Doe, Email:jane@example.com") {
+		t.Errorf("Redacted() with NoRedaction = %q", got)
+	}
+}
+
 func TestSignature_TypeName(t *testing.T) {
 	sig := git.Signature{}
 	if got := sig.TypeName(); got != "Signature" {
@@ -0,0 +1,273 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"dirpx.dev/dxrel/dxcore/errors"
+)
+
+// SignatureFormat identifies the kind of cryptographic signature carried
+// by a commit or tag object. The names returned by String match the values
+// of git's gpg.format setting.
+type SignatureFormat uint8
+
+const (
+	// SignatureNone marks an object without a signature.
+	SignatureNone SignatureFormat = iota
+
+	// SignatureOpenPGP is an ASCII-armored OpenPGP detached signature
+	// ("-----BEGIN PGP SIGNATURE-----").
+	SignatureOpenPGP
+
+	// SignatureSSH is an SSH signature as produced by ssh-keygen -Y sign
+	// ("-----BEGIN SSH SIGNATURE-----").
+	SignatureSSH
+
+	// SignatureX509 is a CMS signature as produced by gpgsm or smimesign
+	// ("-----BEGIN SIGNED MESSAGE-----").
+	SignatureX509
+)
+
+// String returns "none", "openpgp", "ssh" or "x509".
+func (f SignatureFormat) String() string {
+	switch f {
+	case SignatureNone:
+		return "none"
+	case SignatureOpenPGP:
+		return "openpgp"
+	case SignatureSSH:
+		return "ssh"
+	case SignatureX509:
+		return "x509"
+	default:
+		return "SignatureFormat(" + strconv.Itoa(int(f)) + ")"
+	}
+}
+
+// signatureArmors maps the first line of each signature format, as git
+// recognizes them, to the format.
+var signatureArmors = []struct {
+	header string
+	format SignatureFormat
+}{
+	{"-----BEGIN PGP SIGNATURE-----", SignatureOpenPGP},
+	{"-----BEGIN PGP MESSAGE-----", SignatureOpenPGP},
+	{"-----BEGIN SSH SIGNATURE-----", SignatureSSH},
+	{"-----BEGIN SIGNED MESSAGE-----", SignatureX509},
+}
+
+// signatureFormatOf returns the format of the signature starting at the
+// beginning of b, or SignatureNone.
+func signatureFormatOf(b []byte) SignatureFormat {
+	for _, a := range signatureArmors {
+		if bytes.HasPrefix(b, []byte(a.header)) {
+			return a.format
+		}
+	}
+	return SignatureNone
+}
+
+// SignedObject is a raw commit or tag object split into the bytes that were
+// signed and the signature over them. It is the input of signature
+// verification, which needs the exact object as stored by git rather than
+// the parsed Commit or Tag.
+type SignedObject struct {
+	// Type is the git object type, "commit" or "tag".
+	Type string
+
+	// Payload is the signed content: the object without its signature.
+	Payload []byte
+
+	// Signature is the ASCII-armored signature, or nil when the object is
+	// not signed.
+	Signature []byte
+
+	// Format is the format of Signature, or SignatureNone.
+	Format SignatureFormat
+
+	// Signer is the identity and time of the committer of a commit, or of
+	// the tagger of a tag. It is the zero Signature when the object has no
+	// such header.
+	Signer Signature
+}
+
+// IsSigned reports whether the object carries a signature.
+func (o SignedObject) IsSigned() bool {
+	return len(o.Signature) > 0
+}
+
+// ParseSignedCommit splits a raw commit object, as printed by
+// "git cat-file commit <hash>", into its signed payload and the signature
+// of its gpgsig header. Following git, the payload is the object with every
+// gpgsig and gpgsig-sha256 header removed; other multi-line headers such as
+// mergetag are part of the payload. An unsigned commit yields a
+// SignedObject with a nil Signature.
+func ParseSignedCommit(raw []byte) (SignedObject, error) {
+	obj := SignedObject{Type: "commit"}
+	var payload, sig bytes.Buffer
+	// inSig is set within a signature header and its continuation lines,
+	// which are dropped from the payload; keepSig is set while that header
+	// is the one whose signature is returned.
+	inHeaders, inSig, keepSig := true, false, false
+	for len(raw) > 0 {
+		line := raw
+		if i := bytes.IndexByte(raw, '\n'); i >= 0 {
+			line = raw[:i+1]
+		}
+		raw = raw[len(line):]
+
+		if !inHeaders {
+			payload.Write(line)
+			continue
+		}
+		switch {
+		case len(bytes.TrimRight(line, "\n")) == 0:
+			inHeaders = false
+			payload.Write(line)
+		case line[0] == ' ':
+			switch {
+			case keepSig:
+				sig.Write(line[1:])
+			case !inSig:
+				payload.Write(line)
+			}
+		default:
+			name, value, _ := bytes.Cut(line, []byte(" "))
+			inSig = string(name) == "gpgsig" || string(name) == "gpgsig-sha256"
+			// An object signed for both hash algorithms carries two
+			// signature headers; keep the first, which git writes for the
+			// repository's own algorithm, and drop both from the payload.
+			keepSig = inSig && sig.Len() == 0
+			if keepSig {
+				sig.Write(value)
+				continue
+			}
+			if inSig {
+				continue
+			}
+			payload.Write(line)
+			if string(name) == "committer" {
+				signer, err := parseIdentityHeader(string(bytes.TrimRight(value, "\n")))
+				if err != nil {
+					return SignedObject{}, errors.Nest(err, "SignedObject", "Signer")
+				}
+				obj.Signer = signer
+			}
+		}
+	}
+
+	obj.Payload = payload.Bytes()
+	if sig.Len() > 0 {
+		obj.Signature = sig.Bytes()
+		obj.Format = signatureFormatOf(obj.Signature)
+	}
+	return obj, nil
+}
+
+// ParseSignedTag splits a raw tag object, as printed by
+// "git cat-file tag <hash>", into its signed payload and the signature
+// appended to its message. As in git, the signature starts at the last line
+// that begins a recognized signature block; everything before it is the
+// payload. An unsigned tag yields a SignedObject with a nil Signature.
+func ParseSignedTag(raw []byte) (SignedObject, error) {
+	obj := SignedObject{Type: "tag", Payload: raw}
+
+	headers, _, _ := bytes.Cut(raw, []byte("\n\n"))
+	for _, line := range strings.Split(string(headers), "\n") {
+		if value, ok := strings.CutPrefix(line, "tagger "); ok {
+			signer, err := parseIdentityHeader(value)
+			if err != nil {
+				return SignedObject{}, errors.Nest(err, "SignedObject", "Signer")
+			}
+			obj.Signer = signer
+		}
+	}
+
+	start := -1
+	for i := 0; i < len(raw); {
+		if signatureFormatOf(raw[i:]) != SignatureNone {
+			start = i
+		}
+		next := bytes.IndexByte(raw[i:], '\n')
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	if start >= len(headers) {
+		obj.Payload = raw[:start]
+		obj.Signature = raw[start:]
+		obj.Format = signatureFormatOf(obj.Signature)
+	}
+	return obj, nil
+}
+
+// parseIdentityHeader parses the value of an author, committer or tagger
+// header: "Name <email> <unix-seconds> <+hhmm>".
+func parseIdentityHeader(s string) (Signature, error) {
+	open := strings.IndexByte(s, '<')
+	end := strings.LastIndexByte(s, '>')
+	if open < 0 || end < open {
+		return Signature{}, identityError("Email", "missing email", s)
+	}
+	sig := Signature{
+		Name:  strings.TrimSpace(s[:open]),
+		Email: s[open+1 : end],
+	}
+
+	fields := strings.Fields(s[end+1:])
+	if len(fields) != 2 {
+		return Signature{}, identityError("When", "missing timestamp", s)
+	}
+	secs, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return Signature{}, identityError("When", "invalid timestamp", s)
+	}
+	tz := fields[1]
+	if len(tz) != 5 || (tz[0] != '+' && tz[0] != '-') {
+		return Signature{}, identityError("When", "invalid time zone", s)
+	}
+	hh, errH := strconv.Atoi(tz[1:3])
+	mm, errM := strconv.Atoi(tz[3:5])
+	if errH != nil || errM != nil {
+		return Signature{}, identityError("When", "invalid time zone", s)
+	}
+	offset := hh*60*60 + mm*60
+	if tz[0] == '-' {
+		offset = -offset
+	}
+	sig.When = time.Unix(secs, 0).In(time.FixedZone("", offset))
+	return sig, nil
+}
+
+// identityError returns the CodeFormat error of parseIdentityHeader for the
+// header value s, reported at field of Signature.
+func identityError(field, problem, s string) error {
+	return &errors.ValidationError{
+		Code:   errors.CodeFormat,
+		Type:   Signature{}.TypeName(),
+		Field:  field,
+		Reason: fmt.Sprintf("%s in %q", problem, s),
+		Value:  s,
+	}
+}
@@ -0,0 +1,217 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package git_test
+
+import (
+	stderrors "errors"
+	"strings"
+	"testing"
+	"time"
+
+	"dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+const sshSignedCommit = `tree 08585692ce06452da6f82ae66b90d98b55536fca
+author Jane Doe <jane@example.com> 1736933400 +0100
+committer Jane Doe <jane@example.com> 1736933400 +0100
+gpgsig -----BEGIN SSH SIGNATURE-----
+ U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAg7QZnEHbnwZgtw2M3XocRaYDzmR
+ JW8PUblZ1klmPwBRUAAAADZ2l0AAAAAAAAAAZzaGE1MTIAAABTAAAAC3NzaC1lZDI1NTE5
+ AAAAQO9L/MKM7JX+zajKDtFKgE5eU0rVsQbbMvIdmqQbeM8WaSUZTzF5gBFUHm2/MjHeIS
+ B2U0o3Ci0op4Hd01DkBgA=
+ -----END SSH SIGNATURE-----
+
+feat: add a
+
+Body text.
+`
+
+const pgpSignedCommit = `tree f4b354863caa9cea99b95422c9dab70465757d87
+parent 9ff2ebb8e719cb7019ee5a046fca22d05acf8de0
+author Jane Doe <jane@example.com> 1737018000 +0000
+committer Jane Doe <jane@example.com> 1737018000 +0000
+gpgsig -----BEGIN PGP SIGNATURE-----
+ 
+ iHUEABYIAB0WIQSMUuV8i7lTnYnZjqE0EzmMt6vFaAUCatS+TAAKCRA0EzmMt6vF
+ aLWBAQDLTEniLZSz7ru/s0XbI9Yxi64XVcd0di/DkCLEzUDodwD9FvC9IfF9hPMI
+ j9z1AtGT9sRjmdYQL0pDS1OlRX3R5gQ=
+ =51TW
+ -----END PGP SIGNATURE-----
+
+fix: add b
+`
+
+const sshSignedTag = `object 9ff2ebb8e719cb7019ee5a046fca22d05acf8de0
+type commit
+tag v1.0.0
+tagger Jane Doe <jane@example.com> 1736933460 +0100
+
+Release 1.0.0
+-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAg7QZnEHbnwZgtw2M3XocRaYDzmR
+JW8PUblZ1klmPwBRUAAAADZ2l0AAAAAAAAAAZzaGE1MTIAAABTAAAAC3NzaC1lZDI1NTE5
+AAAAQFt6IRaFBuTi3cZMZNxRVMjYfQuvZYaJiZ4JyMvT70VrWlNzVJNjUl58U7RMbEnSQC
+DAqvti9+DlK8yawtFwYwI=
+-----END SSH SIGNATURE-----
+`
+
+func TestParseSignedCommit(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		format  git.SignatureFormat
+		payload string
+	}{
+		{
+			name:   "ssh",
+			raw:    sshSignedCommit,
+			format: git.SignatureSSH,
+			payload: "tree 08585692ce06452da6f82ae66b90d98b55536fca\n" +
+				"author Jane Doe <jane@example.com> 1736933400 +0100\n" +
+				"committer Jane Doe <jane@example.com> 1736933400 +0100\n" +
+				"\nfeat: add a\n\nBody text.\n",
+		},
+		{
+			name:   "openpgp",
+			raw:    pgpSignedCommit,
+			format: git.SignatureOpenPGP,
+			payload: "tree f4b354863caa9cea99b95422c9dab70465757d87\n" +
+				"parent 9ff2ebb8e719cb7019ee5a046fca22d05acf8de0\n" +
+				"author Jane Doe <jane@example.com> 1737018000 +0000\n" +
+				"committer Jane Doe <jane@example.com> 1737018000 +0000\n" +
+				"\nfix: add b\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj, err := git.ParseSignedCommit([]byte(tt.raw))
+			if err != nil {
+				t.Fatalf("ParseSignedCommit() error = %v", err)
+			}
+			if !obj.IsSigned() || obj.Format != tt.format || obj.Type != "commit" {
+				t.Errorf("ParseSignedCommit() = %s %s signed=%t", obj.Type, obj.Format, obj.IsSigned())
+			}
+			if string(obj.Payload) != tt.payload {
+				t.Errorf("Payload = %q, want %q", obj.Payload, tt.payload)
+			}
+			sig := string(obj.Signature)
+			if !strings.HasPrefix(sig, "-----BEGIN ") || !strings.HasSuffix(sig, "-----\n") || strings.Contains(sig, "\n ") {
+				t.Errorf("Signature = %q, want an unindented armored block", sig)
+			}
+			if obj.Signer.Name != "Jane Doe" || obj.Signer.Email != "jane@example.com" {
+				t.Errorf("Signer = %+v", obj.Signer)
+			}
+		})
+	}
+}
+
+func TestParseSignedCommit_DualSigned(t *testing.T) {
+	single, err := git.ParseSignedCommit([]byte(sshSignedCommit))
+	if err != nil {
+		t.Fatalf("ParseSignedCommit() error = %v", err)
+	}
+
+	second := "gpgsig-sha256 -----BEGIN SSH SIGNATURE-----\n" +
+		" AAAA\n" +
+		" BBBB\n" +
+		" -----END SSH SIGNATURE-----\n"
+	headers, message, _ := strings.Cut(sshSignedCommit, "\n\n")
+	dual, err := git.ParseSignedCommit([]byte(headers + "\n" + second + "\n" + message))
+	if err != nil {
+		t.Fatalf("ParseSignedCommit() error = %v", err)
+	}
+
+	if string(dual.Payload) != string(single.Payload) {
+		t.Errorf("Payload = %q, want %q", dual.Payload, single.Payload)
+	}
+	if string(dual.Signature) != string(single.Signature) {
+		t.Errorf("Signature = %q, want the first signature %q", dual.Signature, single.Signature)
+	}
+}
+
+func TestParseSignedCommit_Unsigned(t *testing.T) {
+	raw := "tree 08585692ce06452da6f82ae66b90d98b55536fca\n" +
+		"author Jane Doe <jane@example.com> 1736933400 +0100\n" +
+		"committer Jane Doe <jane@example.com> 1736933400 -0230\n" +
+		"mergetag object 9ff2ebb8e719cb7019ee5a046fca22d05acf8de0\n" +
+		" type commit\n" +
+		" -----BEGIN PGP SIGNATURE-----\n" +
+		" -----END PGP SIGNATURE-----\n" +
+		"\nMerge tag\n"
+	obj, err := git.ParseSignedCommit([]byte(raw))
+	if err != nil {
+		t.Fatalf("ParseSignedCommit() error = %v", err)
+	}
+	if obj.IsSigned() || obj.Format != git.SignatureNone {
+		t.Errorf("ParseSignedCommit() signed = %t, format %s, want unsigned", obj.IsSigned(), obj.Format)
+	}
+	if string(obj.Payload) != raw {
+		t.Errorf("Payload = %q, want the whole object", obj.Payload)
+	}
+	want := time.Date(2025, 1, 15, 7, 0, 0, 0, time.FixedZone("", -(2*60+30)*60))
+	if !obj.Signer.When.Equal(want) {
+		t.Errorf("Signer.When = %v, want %v", obj.Signer.When, want)
+	}
+	if _, offset := obj.Signer.When.Zone(); offset != -(2*60+30)*60 {
+		t.Errorf("Signer.When offset = %d", offset)
+	}
+
+	_, err = git.ParseSignedCommit([]byte("committer Jane <jane@example.com>\n\nx\n"))
+	var ve *errors.ValidationError
+	if !stderrors.As(err, &ve) || ve.Path() != "SignedObject.Signer.When" || ve.Code != errors.CodeFormat {
+		t.Errorf("ParseSignedCommit() with malformed committer error = %v, want invalid_format at SignedObject.Signer.When", err)
+	}
+}
+
+func TestParseSignedTag(t *testing.T) {
+	obj, err := git.ParseSignedTag([]byte(sshSignedTag))
+	if err != nil {
+		t.Fatalf("ParseSignedTag() error = %v", err)
+	}
+	if !obj.IsSigned() || obj.Format != git.SignatureSSH || obj.Type != "tag" {
+		t.Errorf("ParseSignedTag() = %s %s signed=%t", obj.Type, obj.Format, obj.IsSigned())
+	}
+	wantPayload := "object 9ff2ebb8e719cb7019ee5a046fca22d05acf8de0\ntype commit\ntag v1.0.0\n" +
+		"tagger Jane Doe <jane@example.com> 1736933460 +0100\n\nRelease 1.0.0\n"
+	if string(obj.Payload) != wantPayload {
+		t.Errorf("Payload = %q, want %q", obj.Payload, wantPayload)
+	}
+	if obj.Signer.When.Unix() != 1736933460 {
+		t.Errorf("Signer.When = %v", obj.Signer.When)
+	}
+
+	unsigned, err := git.ParseSignedTag([]byte(wantPayload))
+	if err != nil || unsigned.IsSigned() || string(unsigned.Payload) != wantPayload {
+		t.Errorf("ParseSignedTag(unsigned) = %+v, %v", unsigned, err)
+	}
+}
+
+func TestSignatureFormat_String(t *testing.T) {
+	for f, want := range map[git.SignatureFormat]string{
+		git.SignatureNone:      "none",
+		git.SignatureOpenPGP:   "openpgp",
+		git.SignatureSSH:       "ssh",
+		git.SignatureX509:      "x509",
+		git.SignatureFormat(9): "SignatureFormat(9)",
+	} {
+		if got := f.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
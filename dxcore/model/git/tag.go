@@ -17,13 +17,17 @@
 package git
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"regexp"
 	"strings"
 	"unicode"
 
+	"dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/semver"
 	"gopkg.in/yaml.v3"
 )
 
@@ -217,6 +221,11 @@ func (tn TagName) Redacted() string {
 	return string(tn)
 }
 
+// LogValue implements slog.LogValuer, logging the TagName as its Redacted form.
+func (tn TagName) LogValue() slog.Value {
+	return slog.StringValue(tn.Redacted())
+}
+
 // TypeName returns the name of this type for error messages, logging,
 // and debugging.
 //
@@ -275,6 +284,22 @@ func (tn TagName) Equal(other TagName) bool {
 	return tn == other
 }
 
+// Version parses the semantic version in the tag name, read from the part
+// after the last "/" so that module tags such as "cli/v1.2.0" are
+// supported. A leading "v" is accepted. It reports false if that part is not
+// a valid version.
+func (tn TagName) Version() (semver.Version, bool) {
+	name := string(tn)
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	v, err := semver.ParseVersion(name)
+	if err != nil {
+		return semver.Version{}, false
+	}
+	return v, true
+}
+
 // Validate checks whether this TagName satisfies all structural and
 // content requirements for a well-formed Git tag name.
 //
@@ -320,30 +345,60 @@ func (tn TagName) Validate() error {
 
 	// Check for leading/trailing whitespace (should have been normalized)
 	if strings.TrimSpace(str) != str {
-		return fmt.Errorf("TagName %q contains leading or trailing whitespace", str)
+		return &errors.ValidationError{
+			Code:   errors.CodeFormat,
+			Type:   tn.TypeName(),
+			Reason: fmt.Sprintf("TagName %q contains leading or trailing whitespace", str),
+			Value:  str,
+		}
 	}
 
 	// Validate length constraints
 	runeCount := len([]rune(str))
 	if runeCount < TagNameMinLen {
-		return fmt.Errorf("TagName %q is too short: %d runes (minimum %d)", str, runeCount, TagNameMinLen)
+		return &errors.ValidationError{
+			Code:   errors.CodeTooShort,
+			Type:   tn.TypeName(),
+			Reason: fmt.Sprintf("TagName %q is too short: %d runes (minimum %d)", str, runeCount, TagNameMinLen),
+			Value:  str,
+		}
 	}
 	if runeCount > TagNameMaxLen {
-		return fmt.Errorf("TagName %q is too long: %d runes (maximum %d)", str, runeCount, TagNameMaxLen)
+		return &errors.ValidationError{
+			Code:   errors.CodeTooLong,
+			Type:   tn.TypeName(),
+			Reason: fmt.Sprintf("TagName %q is too long: %d runes (maximum %d)", str, runeCount, TagNameMaxLen),
+			Value:  str,
+		}
 	}
 
 	// Validate character set
 	if !TagNameRegexp.MatchString(str) {
-		return fmt.Errorf("TagName %q contains invalid characters (must match pattern %s)", str, tagNamePattern)
+		return &errors.ValidationError{
+			Code:   errors.CodeFormat,
+			Type:   tn.TypeName(),
+			Reason: fmt.Sprintf("TagName %q contains invalid characters (must match pattern %s)", str, tagNamePattern),
+			Value:  str,
+		}
 	}
 
 	// Check for ASCII control characters and other problematic chars
 	for _, r := range str {
 		if unicode.IsControl(r) {
-			return fmt.Errorf("TagName %q contains control character (U+%04X)", str, r)
+			return &errors.ValidationError{
+				Code:   errors.CodeFormat,
+				Type:   tn.TypeName(),
+				Reason: fmt.Sprintf("TagName %q contains control character (U+%04X)", str, r),
+				Value:  str,
+			}
 		}
 		if r > unicode.MaxASCII {
-			return fmt.Errorf("TagName %q contains non-ASCII character %q (U+%04X)", str, r, r)
+			return &errors.ValidationError{
+				Code:   errors.CodeFormat,
+				Type:   tn.TypeName(),
+				Reason: fmt.Sprintf("TagName %q contains non-ASCII character %q (U+%04X)", str, r, r),
+				Value:  str,
+			}
 		}
 	}
 
@@ -588,6 +643,14 @@ type Tag struct {
 	Message string `json:"message,omitempty" yaml:"message,omitempty"`
 }
 
+// Compile-time assertions that Tag implements the optional model
+// interfaces.
+var (
+	_ model.Comparable[Tag] = Tag{}
+	_ model.Cloneable[Tag]  = Tag{}
+	_ model.Ordered[Tag]    = Tag{}
+)
+
 // Compile-time assertion that Tag implements model.Model.
 var _ model.Model = (*Tag)(nil)
 
@@ -695,6 +758,18 @@ func (t Tag) Redacted() string {
 		t.Annotated)
 }
 
+// LogValue implements slog.LogValuer, logging the Tag as a group of its
+// name, object and commit hashes, and whether it is annotated. Like
+// Redacted, it omits the annotation message.
+func (t Tag) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("name", t.Name),
+		slog.Any("object", t.Object),
+		slog.Any("commit", t.Commit),
+		slog.Bool("annotated", t.Annotated),
+	)
+}
+
 // TypeName returns the canonical name of this model type for debugging,
 // logging, and reflection purposes. This method satisfies the model.Identifiable
 // interface's TypeName requirement.
@@ -773,6 +848,58 @@ func (t Tag) Equal(other Tag) bool {
 		t.Message == other.Message
 }
 
+// Clone returns a copy of the Tag. Tag holds only values, so the copy
+// shares nothing with the original.
+//
+// Clone implements model.Cloneable[Tag].
+func (t Tag) Clone() Tag {
+	return t
+}
+
+// Compare orders tags by the semantic version in their name, so that
+// "v1.10.0" sorts after "v1.9.0". The version is read from the part of the
+// name after the last "/", so module tags such as "cli/v1.2.0" are ordered
+// by their version too. Tags whose name is not a version sort after all
+// versioned tags. Ties, for example between "v1.0.0" and "1.0.0" or between
+// the same version of two modules, are broken by Name, then Object, Commit,
+// Annotated and Message, so that the ordering is total and consistent with
+// Equal.
+//
+// Compare implements model.Ordered[Tag], for use with model.Sort.
+func (t Tag) Compare(other Tag) int {
+	v, vok := t.Name.Version()
+	w, wok := other.Name.Version()
+	switch {
+	case vok && wok:
+		if n := v.Compare(w); n != 0 {
+			return n
+		}
+	case vok:
+		return -1
+	case wok:
+		return 1
+	}
+	return cmp.Or(
+		strings.Compare(string(t.Name), string(other.Name)),
+		t.Object.Compare(other.Object),
+		t.Commit.Compare(other.Commit),
+		compareBool(t.Annotated, other.Annotated),
+		strings.Compare(t.Message, other.Message),
+	)
+}
+
+// compareBool orders false before true.
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
+
 // Validate checks whether this Tag satisfies all model contracts and
 // invariants. This method implements the model.Validatable interface's
 // Validate requirement, enforcing data integrity for Git tag records.
@@ -822,43 +949,88 @@ func (t Tag) Equal(other Tag) bool {
 //	err := tag.Validate()
 //	// err: "Tag Message must be empty for lightweight tags"
 func (t Tag) Validate() error {
-	// Validate Name
-	if t.Name.IsZero() {
-		return fmt.Errorf("%s Name must not be empty", t.TypeName())
-	}
-	if err := t.Name.Validate(); err != nil {
-		return fmt.Errorf("invalid %s Name: %w", t.TypeName(), err)
-	}
-
-	// Validate Object
-	if t.Object.IsZero() {
-		return fmt.Errorf("%s Object must not be empty", t.TypeName())
-	}
-	if err := t.Object.Validate(); err != nil {
-		return fmt.Errorf("invalid %s Object: %w", t.TypeName(), err)
-	}
-
-	// Validate Commit
-	if t.Commit.IsZero() {
-		return fmt.Errorf("%s Commit must not be empty", t.TypeName())
-	}
-	if err := t.Commit.Validate(); err != nil {
-		return fmt.Errorf("invalid %s Commit: %w", t.TypeName(), err)
-	}
+	return errors.RunChecks(false, t.checks()...)
+}
 
-	// Validate Message consistency with Annotated flag
-	if !t.Annotated && t.Message != "" {
-		return fmt.Errorf("%s Message must be empty for lightweight tags (got %d bytes)",
-			t.TypeName(), len(t.Message))
-	}
+// ValidateFull is like Validate, but instead of stopping at the first
+// problem it reports one error per invalid field, joined with errors.Join
+// (see errors.Violations).
+func (t Tag) ValidateFull() error {
+	return errors.RunChecks(true, t.checks()...)
+}
 
-	// Validate Message length
-	if len(t.Message) > TagMessageMaxLen {
-		return fmt.Errorf("%s Message exceeds maximum length of %d bytes (got %d)",
-			t.TypeName(), TagMessageMaxLen, len(t.Message))
+// checks returns the validation steps of Validate, one per field.
+func (t Tag) checks() []errors.Check {
+	return []errors.Check{
+		// Validate Name
+		func() error {
+			if t.Name.IsZero() {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   t.TypeName(),
+					Field:  "Name",
+					Reason: fmt.Sprintf("%s Name must not be empty", t.TypeName()),
+				}
+			}
+			if err := t.Name.Validate(); err != nil {
+				return errors.Nest(err, t.TypeName(), "Name")
+			}
+			return nil
+		},
+		// Validate Object
+		func() error {
+			if t.Object.IsZero() {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   t.TypeName(),
+					Field:  "Object",
+					Reason: fmt.Sprintf("%s Object must not be empty", t.TypeName()),
+				}
+			}
+			if err := t.Object.Validate(); err != nil {
+				return errors.Nest(err, t.TypeName(), "Object")
+			}
+			return nil
+		},
+		// Validate Commit
+		func() error {
+			if t.Commit.IsZero() {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   t.TypeName(),
+					Field:  "Commit",
+					Reason: fmt.Sprintf("%s Commit must not be empty", t.TypeName()),
+				}
+			}
+			if err := t.Commit.Validate(); err != nil {
+				return errors.Nest(err, t.TypeName(), "Commit")
+			}
+			return nil
+		},
+		// Validate Message consistency with Annotated flag
+		func() error {
+			if !t.Annotated && t.Message != "" {
+				return &errors.ValidationError{
+					Code:   errors.CodeInconsistent,
+					Type:   t.TypeName(),
+					Field:  "Message",
+					Reason: fmt.Sprintf("%s Message must be empty for lightweight tags (got %d bytes)", t.TypeName(), len(t.Message)),
+				}
+			}
+
+			// Validate Message length
+			if len(t.Message) > TagMessageMaxLen {
+				return &errors.ValidationError{
+					Code:   errors.CodeTooLong,
+					Type:   t.TypeName(),
+					Field:  "Message",
+					Reason: fmt.Sprintf("%s Message exceeds maximum length of %d bytes (got %d)", t.TypeName(), TagMessageMaxLen, len(t.Message)),
+					Value:  len(t.Message),
+				}
+			}
+			return nil
+		},
 	}
-
-	return nil
 }
 
 // MarshalJSON implements json.Marshaler, serializing the Tag to JSON object
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"testing"
 
+	"dirpx.dev/dxrel/dxcore/model"
 	"dirpx.dev/dxrel/dxcore/model/git"
 	"gopkg.in/yaml.v3"
 )
@@ -939,3 +940,58 @@ func TestTag_CommonScenarios(t *testing.T) {
 		})
 	}
 }
+
+func TestTag_Compare(t *testing.T) {
+	hash := git.Hash("a1b2c3d4e5f67890abcdef1234567890abcdef12")
+	tag := func(name string) git.Tag {
+		return git.Tag{Name: git.TagName(name), Object: hash, Commit: hash}
+	}
+	tags := []git.Tag{
+		tag("release-candidate"),
+		tag("v1.10.0"),
+		tag("cli/v1.2.0"),
+		tag("v1.9.0"),
+		tag("v1.10.0-rc.1"),
+		tag("1.9.0"),
+		tag("latest"),
+	}
+	model.Sort(tags)
+
+	var got []string
+	for _, tg := range tags {
+		got = append(got, string(tg.Name))
+	}
+	want := []string{"cli/v1.2.0", "1.9.0", "v1.9.0", "v1.10.0-rc.1", "v1.10.0", "latest", "release-candidate"}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("model.Sort() order = %v, want %v", got, want)
+	}
+
+	annotated := tag("v1.0.0")
+	annotated.Annotated, annotated.Message = true, "Release"
+	if tag("v1.0.0").Compare(annotated) >= 0 || annotated.Compare(tag("v1.0.0")) <= 0 {
+		t.Error("Compare() must order tags that differ only in Annotated")
+	}
+	if tag("v1.0.0").Compare(tag("v1.0.0")) != 0 {
+		t.Error("Compare(self) must be 0")
+	}
+}
+
+func TestTagName_Version(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+		ok   bool
+	}{
+		{"v1.2.3", "1.2.3", true},
+		{"1.2.3-rc.1", "1.2.3-rc.1", true},
+		{"modules/cli/v0.4.0", "0.4.0", true},
+		{"latest", "", false},
+		{"cli/next", "", false},
+	}
+	for _, tt := range tests {
+		v, ok := git.TagName(tt.name).Version()
+		if ok != tt.ok || (ok && v.String() != tt.want) {
+			t.Errorf("Version(%q) = %v, %v, want %s, %v", tt.name, v, ok, tt.want, tt.ok)
+		}
+	}
+}
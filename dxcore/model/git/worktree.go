@@ -19,6 +19,7 @@ package git
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"strings"
 
 	"dirpx.dev/dxrel/dxcore/errors"
@@ -317,6 +318,16 @@ func (ws WorktreeStatus) Redacted() string {
 	return ws.String()
 }
 
+// LogValue implements slog.LogValuer, logging the WorktreeStatus as a group
+// of its flags.
+func (ws WorktreeStatus) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Bool("has_unstaged", ws.HasUnstaged),
+		slog.Bool("has_staged", ws.HasStaged),
+		slog.Bool("has_untracked", ws.HasUntracked),
+	)
+}
+
 // TypeName returns the string "WorktreeStatus", which identifies this type in
 // logs, error messages, and serialized output. This method implements the
 // model.Identifiable contract through model.Model.
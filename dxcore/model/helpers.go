@@ -19,7 +19,9 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 
+	"dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/rxmerr"
 	"gopkg.in/yaml.v3"
 )
@@ -34,7 +36,9 @@ import (
 // its Validate method. When a model fails validation, the error is wrapped
 // with contextual information including the model's position in the slice
 // (zero-indexed) and its type name obtained from TypeName. This allows callers
-// to identify exactly which models failed validation and why.
+// to identify exactly which models failed validation and why. Validate stops
+// at the first violation of each model; use ValidateAllFull to report every
+// violation.
 //
 // If one or more models fail validation, ValidateAll returns a single combined
 // error that aggregates all individual validation failures using rxmerr.Collector.
@@ -55,10 +59,30 @@ import (
 //	    log.Error("validation failed", "error", err)
 //	}
 func ValidateAll[T Model](models []T) error {
+	return validateAll(models, false)
+}
+
+// ValidateAllFull is like ValidateAll but validates models implementing
+// errors.FullValidator with ValidateFull (see errors.ValidateMode), so that
+// every violation of every model is reported rather than only the first of
+// each. Each violation is wrapped separately with the model's position and
+// type name.
+//
+// Example usage for reporting all problems of a batch at once:
+//
+//	if err := ValidateAllFull(commits); err != nil {
+//	    for _, v := range errors.Violations(err) { ... }
+//	}
+func ValidateAllFull[T Model](models []T) error {
+	return validateAll(models, true)
+}
+
+// validateAll implements ValidateAll and ValidateAllFull.
+func validateAll[T Model](models []T, full bool) error {
 	c := rxmerr.NewCollector()
 
 	for i, m := range models {
-		if err := m.Validate(); err != nil {
+		for _, err := range errors.Violations(errors.ValidateMode(m, full)) {
 			c.Append(fmt.Errorf("model[%d] (%s): %w", i, m.TypeName(), err))
 		}
 	}
@@ -357,6 +381,50 @@ func FromYAML[T Model](data []byte, m *T) error {
 	return nil
 }
 
+// ToTOML converts a model to a TOML document after validating it, the way
+// ToJSON and ToYAML do. The document mirrors the model's JSON form (see
+// MarshalTOML), so enums keep the names produced by their MarshalText
+// methods. Because a TOML document is always a table, ToTOML fails for
+// models whose JSON form is not an object, such as a git.Hash or a
+// Strategy on its own; embed those in a struct instead.
+//
+// Example usage for writing a validated model to a configuration file:
+//
+//	data, err := ToTOML(model)
+//	if err != nil {
+//	    return err
+//	}
+//	// Write data to config file
+func ToTOML[T Model](m T) ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("cannot marshal invalid %s: %w", m.TypeName(), err)
+	}
+	return MarshalTOML(m)
+}
+
+// FromTOML parses a TOML document into a model and validates the result,
+// the way FromJSON and FromYAML do. The document is decoded through the
+// model's JSON decoding (see UnmarshalTOML), so it accepts the same field
+// names and enum names as JSON. If FromTOML returns an error, the model
+// variable's state is undefined and MUST NOT be used.
+//
+// Example usage for safely loading a model from a TOML configuration file:
+//
+//	var m ExampleModel
+//	if err := FromTOML(data, &m); err != nil {
+//	    return err
+//	}
+//	// Use m knowing it's valid
+func FromTOML[T Model](data []byte, m *T) error {
+	if err := UnmarshalTOML(data, m); err != nil {
+		return fmt.Errorf("cannot unmarshal TOML: %w", err)
+	}
+	if err := (*m).Validate(); err != nil {
+		return fmt.Errorf("unmarshaled model is invalid: %w", err)
+	}
+	return nil
+}
+
 // Clone creates a deep copy of a model by serializing it to JSON and then
 // deserializing back into a new instance, ensuring complete independence
 // between the original and the copy. This function provides a generic cloning
@@ -382,8 +450,9 @@ func FromYAML[T Model](data []byte, m *T) error {
 // JSON encoding and decoding. For performance-critical code paths that clone
 // models frequently, implementations SHOULD provide a custom Clone method by
 // implementing the Cloneable[T] interface with hand-written copy logic that
-// avoids serialization overhead. For general-purpose code where cloning is
-// infrequent, this generic Clone function provides simplicity and correctness.
+// avoids serialization overhead, and callers SHOULD use it directly or through
+// CloneAll. For general-purpose code where cloning is infrequent, this generic
+// Clone function provides simplicity and correctness.
 //
 // Callers MUST check the returned error before using the cloned model. If
 // Clone returns an error, the model return value is a zero-value instance that
@@ -442,9 +511,10 @@ func Clone[T Model](m T) (T, error) {
 // For performance-critical code paths that compare models frequently,
 // implementations SHOULD provide a custom Equal method by implementing the
 // Comparable[T] interface with hand-written comparison logic that avoids
-// serialization overhead. For general-purpose code where comparison is
-// infrequent, this generic Equal function provides simplicity and works across
-// all Model types uniformly.
+// serialization overhead, and callers SHOULD use it directly or through
+// EqualAll. For general-purpose code where comparison is infrequent, this
+// generic Equal function provides simplicity and works across all Model types
+// uniformly.
 //
 // Example usage for checking if two model instances represent the same data:
 //
@@ -461,3 +531,42 @@ func Equal[T Model](a, b T) bool {
 
 	return string(dataA) == string(dataB)
 }
+
+// Sort sorts models in place in the order defined by their Compare method.
+// Because Compare is a total ordering, the result is deterministic.
+//
+// Example usage for ordering commits chronologically:
+//
+//	model.Sort(commits) // oldest committer time first, ties by hash
+func Sort[T Ordered[T]](models []T) {
+	slices.SortFunc(models, func(a, b T) int { return a.Compare(b) })
+}
+
+// CloneAll returns a new slice holding a deep copy of each model, made with
+// its Clone method rather than a JSON round trip. A nil slice yields nil.
+//
+// Example usage for copying commits before modifying them:
+//
+//	copies := model.CloneAll(commits)
+func CloneAll[T Cloner[T]](models []T) []T {
+	if models == nil {
+		return nil
+	}
+	clones := make([]T, len(models))
+	for i, m := range models {
+		clones[i] = m.Clone()
+	}
+	return clones
+}
+
+// EqualAll reports whether a and b have the same length and their models
+// are pairwise equal according to their Equal method.
+//
+// Example usage for detecting a change in a list of tags:
+//
+//	if !model.EqualAll(before, after) {
+//	    log.Info("tags changed")
+//	}
+func EqualAll[T Equaler[T]](a, b []T) bool {
+	return slices.EqualFunc(a, b, func(x, y T) bool { return x.Equal(y) })
+}
@@ -294,6 +294,11 @@ type Serializable interface {
 // call Redacted on those nested objects to ensure consistent redaction
 // throughout the object graph.
 //
+// Email addresses and names of people SHOULD NOT be masked by hand: pass
+// them through RedactEmail and RedactName so that the process-wide
+// RedactionPolicy decides how they appear. LogValuer adapts any Loggable to
+// log/slog through its Redacted form.
+//
 // Example:
 //
 //	type User struct {
@@ -501,3 +506,45 @@ type Cloneable[T any] interface {
 	// and MUST be safe to call concurrently.
 	Clone() T
 }
+
+// Ordered defines the contract for types with a total ordering. This
+// interface is optional and is implemented by types that are commonly
+// sorted, such as commits (by committer time) and tags (by version).
+//
+// Compare MUST return a negative number when the receiver sorts before
+// other, a positive number when it sorts after, and zero when neither
+// precedes the other. The ordering MUST be total and transitive, and Compare
+// SHOULD return zero only for values that are Equal, so that sorting is
+// deterministic.
+//
+// Compare MUST NOT mutate the receiver or the argument, MUST NOT have side
+// effects, and MUST be safe to call concurrently.
+//
+// Example:
+//
+//	func (u User) Compare(other User) int {
+//	    return strings.Compare(u.Name, other.Name)
+//	}
+type Ordered[T any] interface {
+	// Compare returns -1, 0 or +1 depending on whether this instance sorts
+	// before, together with or after other.
+	//
+	// This method MUST NOT mutate the receiver or the argument, MUST NOT
+	// have side effects, and MUST be safe to call concurrently.
+	Compare(other T) int
+}
+
+// Cloner is the constraint of CloneAll: a type T whose Clone method returns
+// a T, in other words a type that is Cloneable[T]. Like Ordered, it is used
+// as "T Cloner[T]", so that the helpers copy models without reflection or
+// serialization.
+type Cloner[T any] interface {
+	Cloneable[T]
+}
+
+// Equaler is the constraint of EqualAll: a type T whose Equal method takes
+// a T, in other words a type that is Comparable[T]. It is used as
+// "T Equaler[T]".
+type Equaler[T any] interface {
+	Comparable[T]
+}
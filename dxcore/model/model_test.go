@@ -21,7 +21,9 @@ import (
 	"errors"
 	"testing"
 
+	dxerrors "dirpx.dev/dxrel/dxcore/errors"
 	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/git"
 	"gopkg.in/yaml.v3"
 )
 
@@ -329,3 +331,28 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestValidateAll(t *testing.T) {
+	valid := codecCommit()
+	// Two violations: the hash and the parent.
+	invalid := codecCommit()
+	invalid.Hash = "not-a-hash"
+	invalid.Parents = []git.Hash{"also-not-a-hash"}
+	commits := []*git.Commit{valid, invalid, invalid}
+
+	if n := len(dxerrors.Violations(invalid.ValidateFull())); n != 2 {
+		t.Fatalf("ValidateFull() reported %d violations, want 2", n)
+	}
+	if err := model.ValidateAll([]*git.Commit{valid}); err != nil {
+		t.Errorf("ValidateAll(valid) = %v", err)
+	}
+
+	// ValidateAll fails fast within each model but checks every model.
+	if got := dxerrors.Violations(model.ValidateAll(commits)); len(got) != 2 {
+		t.Errorf("ValidateAll() reported %d errors, want one per invalid model: %v", len(got), got)
+	}
+	// ValidateAllFull reports every violation of every model.
+	if got := dxerrors.Violations(model.ValidateAllFull(commits)); len(got) != 4 {
+		t.Errorf("ValidateAllFull() reported %d errors, want 4: %v", len(got), got)
+	}
+}
@@ -0,0 +1,205 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+)
+
+// RedactionPolicy decides how personal data appears in the Redacted output
+// of models. Every Redacted implementation that includes an email address
+// or a person's name passes it through the current policy (see
+// SetRedactionPolicy) instead of hard-coding the masking, so that the same
+// models can log pseudonymized identities in one deployment and full
+// identities in internal tooling.
+//
+// Implementations MUST be safe for concurrent use and SHOULD be pure: the
+// same input SHOULD always produce the same output, so that redacted logs
+// remain correlatable.
+type RedactionPolicy interface {
+	// RedactEmail returns the form of an email address that may be logged.
+	RedactEmail(email string) string
+
+	// RedactName returns the form of a person's name that may be logged. An
+	// empty result drops the name.
+	RedactName(name string) string
+}
+
+// Redaction is a RedactionPolicy assembled from one rule per kind of
+// personal data. A nil rule leaves the value unchanged.
+//
+// Example, for logs that must not identify anyone but still let operators
+// count distinct authors:
+//
+//	model.SetRedactionPolicy(model.Redaction{
+//	    Email: model.HashEmail(salt),
+//	    Name:  model.DropName,
+//	})
+type Redaction struct {
+	// Email redacts email addresses, for example MaskEmail, EmailDomain or
+	// the result of HashEmail.
+	Email func(email string) string
+
+	// Name redacts names of people, for example DropName.
+	Name func(name string) string
+}
+
+// RedactEmail applies the Email rule.
+func (r Redaction) RedactEmail(email string) string {
+	if r.Email == nil {
+		return email
+	}
+	return r.Email(email)
+}
+
+// RedactName applies the Name rule.
+func (r Redaction) RedactName(name string) string {
+	if r.Name == nil {
+		return name
+	}
+	return r.Name(name)
+}
+
+var (
+	// DefaultRedaction masks the local part of email addresses with
+	// MaskEmail and keeps names. It is the policy in effect unless
+	// SetRedactionPolicy is called.
+	DefaultRedaction RedactionPolicy = Redaction{Email: MaskEmail}
+
+	// NoRedaction keeps email addresses and names unchanged. It is meant
+	// for internal logs where full identities are acceptable.
+	NoRedaction RedactionPolicy = Redaction{}
+)
+
+// redactionPolicy holds the policy consulted by RedactEmail and RedactName.
+var redactionPolicy atomic.Pointer[RedactionPolicy]
+
+// SetRedactionPolicy makes p the policy consulted by the Redacted methods
+// of all models and returns the previous policy. A nil p restores
+// DefaultRedaction. It is safe for concurrent use, but since the policy is
+// process-wide it SHOULD be set once during start-up.
+func SetRedactionPolicy(p RedactionPolicy) RedactionPolicy {
+	if p == nil {
+		p = DefaultRedaction
+	}
+	if prev := redactionPolicy.Swap(&p); prev != nil {
+		return *prev
+	}
+	return DefaultRedaction
+}
+
+// CurrentRedactionPolicy returns the policy set with SetRedactionPolicy, or
+// DefaultRedaction.
+func CurrentRedactionPolicy() RedactionPolicy {
+	if p := redactionPolicy.Load(); p != nil {
+		return *p
+	}
+	return DefaultRedaction
+}
+
+// RedactEmail redacts email with the current policy. Redacted
+// implementations MUST use it for every email address they include.
+func RedactEmail(email string) string {
+	return CurrentRedactionPolicy().RedactEmail(email)
+}
+
+// RedactName redacts the name of a person with the current policy.
+// Redacted implementations MUST use it for every name they include.
+func RedactName(name string) string {
+	return CurrentRedactionPolicy().RedactName(name)
+}
+
+// MaskEmail masks the local part of an email address, keeping its first
+// character and the domain: "jane@example.com" -> "j***@example.com". Empty
+// and malformed addresses yield "[empty]" and "[invalid]" respectively.
+func MaskEmail(email string) string {
+	if email == "" {
+		return "[empty]"
+	}
+	at := strings.Index(email, "@")
+	if at <= 0 {
+		return "[invalid]"
+	}
+	return email[:1] + "***" + email[at:]
+}
+
+// EmailDomain keeps only the domain of an email address:
+// "jane@example.com" -> "@example.com". Empty and malformed addresses yield
+// "[empty]" and "[invalid]" respectively.
+func EmailDomain(email string) string {
+	if email == "" {
+		return "[empty]"
+	}
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return "[invalid]"
+	}
+	return email[at:]
+}
+
+// HashEmail returns a rule that replaces an email address with a keyed
+// HMAC-SHA256 digest of it, for example "sha256:3f0c9b1e2d4a5c6b". The
+// address is trimmed and lowercased first, so that the same mailbox always
+// yields the same digest and logs remain correlatable without revealing the
+// address. The salt MUST be kept secret; without it the digest of a known
+// address can be recomputed. An empty address yields "[empty]".
+func HashEmail(salt []byte) func(email string) string {
+	salt = append([]byte(nil), salt...)
+	return func(email string) string {
+		email = strings.ToLower(strings.TrimSpace(email))
+		if email == "" {
+			return "[empty]"
+		}
+		mac := hmac.New(sha256.New, salt)
+		mac.Write([]byte(email))
+		return "sha256:" + hex.EncodeToString(mac.Sum(nil)[:8])
+	}
+}
+
+// DropName removes a name entirely.
+func DropName(string) string {
+	return ""
+}
+
+// LogValuer adapts m to slog.LogValuer, so that it is logged through its
+// Redacted form. Models that implement slog.LogValuer themselves are
+// returned unchanged.
+//
+// Example:
+//
+//	slog.Info("release planned", "commit", model.LogValuer(c))
+func LogValuer(m Loggable) slog.LogValuer {
+	if lv, ok := m.(slog.LogValuer); ok {
+		return lv
+	}
+	return redactedValuer{m}
+}
+
+// redactedValuer logs a Loggable as its Redacted string.
+type redactedValuer struct {
+	m Loggable
+}
+
+// LogValue implements slog.LogValuer.
+func (v redactedValuer) LogValue() slog.Value {
+	return slog.StringValue(v.m.Redacted())
+}
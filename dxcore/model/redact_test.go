@@ -0,0 +1,138 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package model_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+func TestMaskEmail(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"jane@example.com", "j***@example.com"},
+		{"a@b.c", "a***@b.c"},
+		{"", "[empty]"},
+		{"invalid", "[invalid]"},
+		{"@example.com", "[invalid]"},
+	}
+	for _, tt := range tests {
+		if got := model.MaskEmail(tt.in); got != tt.want {
+			t.Errorf("MaskEmail(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestEmailDomain(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"jane@example.com", "@example.com"},
+		{"", "[empty]"},
+		{"invalid", "[invalid]"},
+		{"jane@", "[invalid]"},
+	}
+	for _, tt := range tests {
+		if got := model.EmailDomain(tt.in); got != tt.want {
+			t.Errorf("EmailDomain(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestHashEmail(t *testing.T) {
+	hash := model.HashEmail([]byte("salt"))
+	got := hash("jane@example.com")
+	if !strings.HasPrefix(got, "sha256:") || len(got) != len("sha256:")+16 {
+		t.Errorf("HashEmail() = %q, want sha256: and 16 hex digits", got)
+	}
+	if strings.Contains(got, "jane") || strings.Contains(got, "example") {
+		t.Errorf("HashEmail() = %q leaks the address", got)
+	}
+	if again := hash(" Jane@Example.com "); again != got {
+		t.Errorf("HashEmail() of the same mailbox = %q, want %q", again, got)
+	}
+	if other := model.HashEmail([]byte("pepper"))("jane@example.com"); other == got {
+		t.Error("HashEmail() with a different salt gave the same digest")
+	}
+	if empty := hash(""); empty != "[empty]" {
+		t.Errorf("HashEmail(\"\") = %q, want [empty]", empty)
+	}
+}
+
+func TestSetRedactionPolicy(t *testing.T) {
+	if got := model.RedactEmail("jane@example.com"); got != "j***@example.com" {
+		t.Errorf("RedactEmail() = %q with the default policy", got)
+	}
+
+	prev := model.SetRedactionPolicy(model.Redaction{Email: model.EmailDomain, Name: model.DropName})
+	defer model.SetRedactionPolicy(prev)
+	if got := prev.RedactEmail("jane@example.com"); got != "j***@example.com" {
+		t.Errorf("SetRedactionPolicy() returned a policy giving %q, want DefaultRedaction", got)
+	}
+	if got := model.RedactEmail("jane@example.com"); got != "@example.com" {
+		t.Errorf("RedactEmail() = %q, want @example.com", got)
+	}
+	if got := model.RedactName("Jane Doe"); got != "" {
+		t.Errorf("RedactName() = %q, want empty", got)
+	}
+
+	model.SetRedactionPolicy(model.NoRedaction)
+	if got := model.RedactEmail("jane@example.com"); got != "jane@example.com" {
+		t.Errorf("RedactEmail() = %q with NoRedaction", got)
+	}
+
+	model.SetRedactionPolicy(nil)
+	if got := model.CurrentRedactionPolicy().RedactEmail("jane@example.com"); got != "j***@example.com" {
+		t.Errorf("SetRedactionPolicy(nil) left a policy giving %q, want DefaultRedaction", got)
+	}
+}
+
+func TestLogValuer(t *testing.T) {
+	m := ExampleModel{Name: "Jane", Email: "jane@example.com", Password: "secret"}
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	logger.Info("saved", "model", model.LogValuer(m))
+
+	out := buf.String()
+	if strings.Contains(out, "secret") || strings.Contains(out, "jane@example.com") {
+		t.Errorf("log output leaks sensitive data: %s", out)
+	}
+	if !strings.Contains(out, "j***@example.com") {
+		t.Errorf("log output = %s, want the redacted form", out)
+	}
+}
+
+func TestLogValue_AllModels(t *testing.T) {
+	models := []model.Loggable{
+		git.Hash(""), git.RefName("main"), git.RefKindBranch, git.Ref{}, git.CommitRange{},
+		git.CommitRangeSpec{}, git.TagName("v1.0.0"), git.Tag{}, git.Signature{},
+		git.FileChangeAdded, git.FileChange{}, git.Commit{}, git.WorktreeStatus{},
+		conventional.Feat, conventional.Scope("api"), conventional.Subject("x"),
+		conventional.Body("y"), conventional.Trailer{}, conventional.Person{},
+		conventional.IssueRef{Number: 1}, conventional.Message{},
+		change.BumpMinor, model.Sequential, model.ClassifySquash,
+	}
+	for _, m := range models {
+		if _, ok := m.(slog.LogValuer); !ok {
+			t.Errorf("%T does not implement slog.LogValuer", m)
+		}
+	}
+}
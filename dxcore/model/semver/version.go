@@ -240,20 +240,31 @@ func fromBlangSemver(bv bsemver.Version) Version {
 // or before emitting a version into user-facing output.
 func (v Version) Validate() error {
 	// Check for negative values (blang/semver uses uint64, so we need this check)
-	if v.Major < 0 {
-		return fmt.Errorf("Major version component must be non-negative, got %d", v.Major)
-	}
-	if v.Minor < 0 {
-		return fmt.Errorf("Minor version component must be non-negative, got %d", v.Minor)
-	}
-	if v.Patch < 0 {
-		return fmt.Errorf("Patch version component must be non-negative, got %d", v.Patch)
+	for _, c := range []struct {
+		field string
+		value int
+	}{{"Major", v.Major}, {"Minor", v.Minor}, {"Patch", v.Patch}} {
+		if c.value < 0 {
+			return &dxerrors.ValidationError{
+				Code:   dxerrors.CodeOutOfRange,
+				Type:   "Version",
+				Field:  c.field,
+				Reason: fmt.Sprintf("must be non-negative, got %d", c.value),
+				Value:  c.value,
+			}
+		}
 	}
 
 	// Use blang/semver for validation
 	_, err := v.toBlangSemver()
 	if err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+		return &dxerrors.ValidationError{
+			Code:   dxerrors.CodeFormat,
+			Type:   "Version",
+			Reason: err.Error(),
+			Value:  v.String(),
+			Err:    err,
+		}
 	}
 
 	return nil
@@ -18,6 +18,7 @@ package model
 
 import (
 	"encoding/json"
+	"log/slog"
 
 	"dirpx.dev/dxrel/dxcore/errors"
 	"gopkg.in/yaml.v3"
@@ -268,6 +269,11 @@ func (s Strategy) Redacted() string {
 	return s.String()
 }
 
+// LogValue implements slog.LogValuer, logging the Strategy as its Redacted form.
+func (s Strategy) LogValue() slog.Value {
+	return slog.StringValue(s.Redacted())
+}
+
 // IsZero reports whether the Strategy has its zero value.
 //
 // For Strategy (an enum type), the zero value is MaxSeverity (constant 0).
@@ -314,8 +320,8 @@ func (s Strategy) Equal(other any) bool {
 func (s Strategy) Validate() error {
 	if !s.Valid() {
 		return &errors.ValidationError{
+			Code:   errors.CodeOutOfRange,
 			Type:   "Strategy",
-			Field:  "",
 			Reason: "invalid Strategy value",
 			Value:  int(s),
 		}
@@ -0,0 +1,245 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package model
+
+import (
+	stderrors "errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"dirpx.dev/dxrel/dxcore/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// FromJSONStrict is FromJSON in strict mode: before decoding, it rejects
+// documents that contain fields the model does not define, instead of
+// silently ignoring them. Each unknown field is reported as an
+// *errors.UnknownFieldError carrying its position in the document and, for
+// likely typos, the closest known field name; several unknown fields are
+// joined with errors.Join.
+//
+// Field names are matched the way encoding/json matches them: against the
+// json tag or, without one, the Go field name, ignoring case.
+//
+// Example:
+//
+//	var c *git.Commit
+//	err := FromJSONStrict([]byte(`{"hash": "...", "mesage": "fix: x"}`), &c)
+//	// err: dxapi: unknown field mesage in Commit at line 1, column 17; did you mean "message"?
+func FromJSONStrict[T Model](data []byte, m *T) error {
+	if err := checkFields(data, reflect.TypeFor[T](), true); err != nil {
+		return err
+	}
+	return FromJSON(data, m)
+}
+
+// FromYAMLStrict is FromYAML in strict mode; see FromJSONStrict. Field
+// names are matched the way gopkg.in/yaml.v3 matches them: against the
+// yaml tag or, without one, the lowercased Go field name.
+//
+// Example:
+//
+//	var c *git.Commit
+//	err := FromYAMLStrict([]byte("hash: ...\nmesage: fix: x\n"), &c)
+//	// err: dxapi: unknown field mesage in Commit at line 2, column 1; did you mean "message"?
+func FromYAMLStrict[T Model](data []byte, m *T) error {
+	if err := checkFields(data, reflect.TypeFor[T](), false); err != nil {
+		return err
+	}
+	return FromYAML(data, m)
+}
+
+// checkFields reports the fields of the JSON or YAML document data that t
+// does not define. JSON documents are parsed with the YAML parser, which
+// accepts them and records the positions of their keys.
+func checkFields(data []byte, t reflect.Type, json bool) error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		// Leave syntax errors to the decoder, which reports them in the
+		// terms of the format.
+		return nil
+	}
+	t = indirectType(t)
+	return stderrors.Join(unknownFields(&doc, t, t.Name(), "", json)...)
+}
+
+// timeType is decoded from a scalar despite being a struct.
+var timeType = reflect.TypeFor[time.Time]()
+
+// unknownFields walks node alongside the Go type t and returns an
+// *errors.UnknownFieldError for every mapping key that t does not define.
+// Values whose node shape does not match t, such as a struct decoded from a
+// string by a custom unmarshaler, are not inspected.
+func unknownFields(node *yaml.Node, t reflect.Type, typ, path string, json bool) []error {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil
+		}
+		return unknownFields(node.Content[0], t, typ, path, json)
+	case yaml.AliasNode:
+		return unknownFields(node.Alias, t, typ, path, json)
+	}
+
+	t = indirectType(t)
+	var errs []error
+	switch {
+	case t.Kind() == reflect.Struct && t != timeType && node.Kind == yaml.MappingNode:
+		fields := structFields(t, json)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value == "<<" {
+				continue
+			}
+			field, ok := lookupField(fields, key.Value, json)
+			if !ok {
+				errs = append(errs, &errors.UnknownFieldError{
+					Type:       typ,
+					Field:      errors.JoinPath(path, key.Value),
+					Key:        key.Value,
+					Line:       key.Line,
+					Column:     key.Column,
+					Suggestion: suggestField(fields, key.Value),
+				})
+				continue
+			}
+			errs = append(errs, unknownFields(value, field.typ, typ, errors.JoinPath(path, key.Value), json)...)
+		}
+	case t.Kind() == reflect.Map && node.Kind == yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			errs = append(errs, unknownFields(node.Content[i+1], t.Elem(), typ, errors.JoinPath(path, node.Content[i].Value), json)...)
+		}
+	case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && node.Kind == yaml.SequenceNode:
+		for i, item := range node.Content {
+			errs = append(errs, unknownFields(item, t.Elem(), typ, path+"["+strconv.Itoa(i)+"]", json)...)
+		}
+	}
+	return errs
+}
+
+// indirectType strips pointers from t.
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// field is a decodable field of a struct under its document name.
+type field struct {
+	name string
+	typ  reflect.Type
+}
+
+// structFields lists the fields of t as encoding/json (json set) or
+// gopkg.in/yaml.v3 names them, including the fields of embedded structs
+// that are flattened into t.
+func structFields(t reflect.Type, json bool) []field {
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("yaml")
+		if json {
+			tag = f.Tag.Get("json")
+		}
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		flatten := f.Anonymous && name == ""
+		if !json {
+			flatten = strings.Contains(","+opts+",", ",inline,")
+		}
+		if ft := indirectType(f.Type); flatten && ft.Kind() == reflect.Struct {
+			fields = append(fields, structFields(ft, json)...)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+			if !json {
+				name = strings.ToLower(f.Name)
+			}
+		}
+		fields = append(fields, field{name: name, typ: f.Type})
+	}
+	return fields
+}
+
+// lookupField finds the field named key, ignoring case for JSON as
+// encoding/json does.
+func lookupField(fields []field, key string, json bool) (field, bool) {
+	for _, f := range fields {
+		if f.name == key {
+			return f, true
+		}
+	}
+	if json {
+		for _, f := range fields {
+			if strings.EqualFold(f.name, key) {
+				return f, true
+			}
+		}
+	}
+	return field{}, false
+}
+
+// suggestField returns the field name closest to key when it is within a
+// third of key's length in edit distance, and "" otherwise.
+func suggestField(fields []field, key string) string {
+	limit := max(1, len(key)/3)
+	best, bestDist := "", limit+1
+	for _, f := range fields {
+		if d := editDistance(strings.ToLower(key), strings.ToLower(f.name)); d < bestDist {
+			best, bestDist = f.name, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the optimal string alignment distance between a and
+// b: the number of single-character insertions, deletions, substitutions
+// and transpositions of adjacent characters needed to turn a into b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	// d[i][j] is the distance between ra[:i] and rb[:j].
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ra)][len(rb)]
+}
@@ -0,0 +1,140 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package model_test
+
+import (
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+const strictCommitYAML = `hash: a1b2c3d4e5f67890abcdef1234567890abcdef12
+author:
+  name: Jane Doe
+  emial: jane@example.com
+  when: 2025-03-04T05:06:07+02:00
+committer:
+  name: Jane Doe
+  email: jane@example.com
+  when: 2025-03-04T05:06:07+02:00
+mesage: "fix: x"
+summary: "fix: x"
+changes:
+  - path: a.go
+    kind: added
+  - pth: b.go
+    kind: modified
+`
+
+func TestFromYAMLStrict_UnknownFields(t *testing.T) {
+	var c *git.Commit
+	err := model.FromYAMLStrict([]byte(strictCommitYAML), &c)
+	if err == nil {
+		t.Fatal("FromYAMLStrict() succeeded, want error")
+	}
+
+	want := []errors.UnknownFieldError{
+		{Type: "Commit", Field: "author.emial", Key: "emial", Line: 4, Column: 3, Suggestion: "email"},
+		{Type: "Commit", Field: "mesage", Key: "mesage", Line: 10, Column: 1, Suggestion: "message"},
+		{Type: "Commit", Field: "changes[1].pth", Key: "pth", Line: 15, Column: 5, Suggestion: "path"},
+	}
+	got := errors.Violations(err)
+	if len(got) != len(want) {
+		t.Fatalf("FromYAMLStrict() violations = %v, want %d", got, len(want))
+	}
+	for i, e := range got {
+		var ufe *errors.UnknownFieldError
+		if !stderrors.As(e, &ufe) {
+			t.Fatalf("violation %d = %T, want *errors.UnknownFieldError", i, e)
+		}
+		if *ufe != want[i] {
+			t.Errorf("violation %d = %+v, want %+v", i, *ufe, want[i])
+		}
+	}
+	if msg := got[1].Error(); msg != `dxapi: unknown field mesage in Commit at line 10, column 1; did you mean "message"?` {
+		t.Errorf("Error() = %s", msg)
+	}
+
+	// Without strict mode the unknown fields are ignored and the commit
+	// fails validation for the missing message instead.
+	if err := model.FromYAML([]byte(strictCommitYAML), &c); err == nil || strings.Contains(err.Error(), "unknown field") {
+		t.Errorf("FromYAML() error = %v, want validation error", err)
+	}
+}
+
+func TestFromYAMLStrict_Valid(t *testing.T) {
+	want := codecCommit()
+	data, err := model.ToYAML(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got *git.Commit
+	if err := model.FromYAMLStrict(data, &got); err != nil {
+		t.Fatalf("FromYAMLStrict() error = %v", err)
+	}
+	if !got.Equal(*want) {
+		t.Errorf("FromYAMLStrict() = %+v, want %+v", got, want)
+	}
+
+	// Ref has no yaml tags; yaml.v3 uses the lowercased field names.
+	var ref *git.Ref
+	if err := model.FromYAMLStrict([]byte("name: main\nkind: branch\n"), &ref); err != nil {
+		t.Errorf("FromYAMLStrict(Ref) error = %v", err)
+	}
+}
+
+func TestFromJSONStrict(t *testing.T) {
+	want := codecCommit()
+	data, err := model.ToJSON(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got *git.Commit
+	if err := model.FromJSONStrict(data, &got); err != nil {
+		t.Fatalf("FromJSONStrict() error = %v", err)
+	}
+
+	// Ref has no json tags; keys match its field names ignoring case.
+	var ref *git.Ref
+	if err := model.FromJSONStrict([]byte(`{"name": "main", "KIND": "branch"}`), &ref); err != nil {
+		t.Errorf("FromJSONStrict(Ref) error = %v", err)
+	}
+
+	doc := "{\n  \"hash\": \"a1b2c3d4e5f67890abcdef1234567890abcdef12\",\n  \"parnets\": [],\n  \"xyzzy\": 1\n}"
+	err = model.FromJSONStrict([]byte(doc), &got)
+	want2 := []errors.UnknownFieldError{
+		{Type: "Commit", Field: "parnets", Key: "parnets", Line: 3, Column: 3, Suggestion: "parents"},
+		{Type: "Commit", Field: "xyzzy", Key: "xyzzy", Line: 4, Column: 3},
+	}
+	violations := errors.Violations(err)
+	if len(violations) != len(want2) {
+		t.Fatalf("FromJSONStrict() violations = %v, want %d", violations, len(want2))
+	}
+	for i, e := range violations {
+		if ufe, ok := e.(*errors.UnknownFieldError); !ok || *ufe != want2[i] {
+			t.Errorf("violation %d = %#v, want %+v", i, e, want2[i])
+		}
+	}
+
+	if err := model.FromJSONStrict([]byte(`{"hash": `), &got); err == nil {
+		t.Error("FromJSONStrict() of malformed JSON succeeded, want error")
+	}
+}
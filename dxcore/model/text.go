@@ -0,0 +1,313 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package model
+
+import (
+	stderrors "errors"
+	"fmt"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+
+	"dirpx.dev/dxrel/dxcore/errors"
+)
+
+// UnsafeKind classifies characters that can make rendered text differ from
+// what a reviewer saw, for example in a changelog entry.
+type UnsafeKind int
+
+const (
+	// UnsafeControl is a control character other than tab and line feed,
+	// such as an ANSI escape that rewrites terminal output.
+	UnsafeControl UnsafeKind = iota
+
+	// UnsafeBidi is a bidirectional control character (see IsBidiControl),
+	// the basis of "Trojan Source" attacks.
+	UnsafeBidi
+
+	// UnsafeInvisible is an invisible character (see IsInvisible) outside
+	// the emoji and script contexts where NormalizeText keeps it.
+	UnsafeInvisible
+
+	// UnsafeHomoglyph is a letter from a different script than the rest of
+	// its word, for example a Cyrillic "а" in an otherwise Latin "pаypal".
+	UnsafeHomoglyph
+)
+
+// String returns "control", "bidi", "invisible" or "homoglyph", or "unknown"
+// for undefined values.
+func (k UnsafeKind) String() string {
+	switch k {
+	case UnsafeControl:
+		return "control"
+	case UnsafeBidi:
+		return "bidi"
+	case UnsafeInvisible:
+		return "invisible"
+	case UnsafeHomoglyph:
+		return "homoglyph"
+	default:
+		return "unknown"
+	}
+}
+
+// reason returns the diagnostic text for k.
+func (k UnsafeKind) reason() string {
+	switch k {
+	case UnsafeControl:
+		return "control character"
+	case UnsafeBidi:
+		return "bidirectional control character"
+	case UnsafeInvisible:
+		return "invisible character"
+	case UnsafeHomoglyph:
+		return "mixed-script word (possible homoglyph)"
+	default:
+		return "unsafe character"
+	}
+}
+
+// UnsafeChar is one occurrence of a character reported by ScanText.
+type UnsafeChar struct {
+	// Kind classifies the character.
+	Kind UnsafeKind
+
+	// Rune is the offending character.
+	Rune rune
+
+	// Offset is the byte offset of Rune in the scanned text.
+	Offset int
+
+	// Line and Column locate Rune in the scanned text. Both are 1-based and
+	// Column counts Unicode code points.
+	Line, Column int
+}
+
+// confusableScripts are the scripts whose letters are commonly mistaken for
+// one another. A word mixing letters from two of them is reported as a
+// homoglyph.
+var confusableScripts = []*unicode.RangeTable{
+	unicode.Latin,
+	unicode.Cyrillic,
+	unicode.Greek,
+	unicode.Armenian,
+	unicode.Cherokee,
+}
+
+// ScanText reports the characters of s that can spoof rendered output:
+// control characters, bidirectional controls, invisible characters and
+// letters that mix confusable scripts (Latin, Cyrillic, Greek, Armenian and
+// Cherokee) within a single word. For a mixed-script word, the first letter
+// outside the word's majority script is reported, so that a Cyrillic "а" in
+// "аdmin" is flagged rather than the Latin letters around it. Characters
+// that NormalizeText keeps (tab, line feed, joiners inside emoji sequences
+// and scripts that need them, emoji tag sequences) are not reported.
+//
+// Results are ordered by position.
+func ScanText(s string) []UnsafeChar {
+	var (
+		found        []UnsafeChar
+		line, column = 1, 0
+		runes        = []rune(s)
+		i            = -1
+		prevKept     = rune(-1)
+
+		word        []UnsafeChar // letters of the current word
+		wordScripts []int        // confusable script index of each letter
+	)
+	flushWord := func() {
+		if c, ok := minorityLetter(word, wordScripts); ok {
+			c.Kind = UnsafeHomoglyph
+			found = append(found, c)
+		}
+		word, wordScripts = word[:0], wordScripts[:0]
+	}
+
+	for offset, r := range s {
+		i++
+		column++
+		char := UnsafeChar{Rune: r, Offset: offset, Line: line, Column: column}
+
+		kind, unsafe := classifyUnsafe(runes, i, prevKept)
+		if unsafe {
+			char.Kind = kind
+			found = append(found, char)
+		} else {
+			prevKept = r
+		}
+
+		switch {
+		case unicode.IsLetter(r):
+			if script := scriptIndex(r); script >= 0 {
+				word = append(word, char)
+				wordScripts = append(wordScripts, script)
+			}
+		case unicode.IsMark(r), unsafe:
+			// Marks and hidden characters do not end a word.
+		default:
+			flushWord()
+		}
+
+		if r == '\n' {
+			line, column = line+1, 0
+		}
+	}
+	flushWord()
+
+	sort.SliceStable(found, func(a, b int) bool { return found[a].Offset < found[b].Offset })
+	return found
+}
+
+// minorityLetter returns the first letter of a word whose script differs
+// from the script most of the word's letters belong to. Ties are resolved in
+// favor of the script of the first letter.
+func minorityLetter(word []UnsafeChar, scripts []int) (UnsafeChar, bool) {
+	if len(word) < 2 {
+		return UnsafeChar{}, false
+	}
+	counts := make(map[int]int)
+	for _, sc := range scripts {
+		counts[sc]++
+	}
+	if len(counts) < 2 {
+		return UnsafeChar{}, false
+	}
+	majority := scripts[0]
+	for _, sc := range scripts {
+		if counts[sc] > counts[majority] {
+			majority = sc
+		}
+	}
+	for i, sc := range scripts {
+		if sc != majority {
+			return word[i], true
+		}
+	}
+	return UnsafeChar{}, false
+}
+
+// classifyUnsafe reports whether runes[i] is an unsafe control, bidi or
+// invisible character. prev is the last character that was not reported.
+func classifyUnsafe(runes []rune, i int, prev rune) (UnsafeKind, bool) {
+	r := runes[i]
+	switch {
+	case r == '\t' || r == '\n':
+		return 0, false
+	case r == '\r' && i+1 < len(runes) && runes[i+1] == '\n':
+		return 0, false
+	case unicode.IsControl(r):
+		return UnsafeControl, true
+	case IsBidiControl(r):
+		return UnsafeBidi, true
+	case r == zeroWidthJoiner || r == zeroWidthNonJoiner:
+		if prev >= 0 && i+1 < len(runes) && isJoinable(prev) && isJoinable(runes[i+1]) {
+			return 0, false
+		}
+		return UnsafeInvisible, true
+	case isTag(r):
+		if prev == blackFlag || isTag(prev) {
+			return 0, false
+		}
+		return UnsafeInvisible, true
+	case IsInvisible(r):
+		return UnsafeInvisible, true
+	}
+	return 0, false
+}
+
+// scriptIndex returns the index in confusableScripts of the script of r, or
+// -1 when r belongs to none of them.
+func scriptIndex(r rune) int {
+	for i, script := range confusableScripts {
+		if unicode.Is(script, r) {
+			return i
+		}
+	}
+	return -1
+}
+
+// CheckText scans s with ScanText and returns nil if nothing was found.
+// Otherwise it returns one *errors.ParseError per finding, joined with
+// errors.Join, each carrying typ as its Type, the character as "U+XXXX" as
+// its Value, and the position and kind of the finding.
+//
+// Callers SHOULD run CheckText on commit messages, scopes and author names
+// before rendering them into changelogs or release notes.
+func CheckText(typ, s string) error {
+	var errs []error
+	for _, c := range ScanText(s) {
+		errs = append(errs, &errors.ParseError{
+			Type:   typ,
+			Value:  fmt.Sprintf("%U", c.Rune),
+			Line:   c.Line,
+			Column: c.Column,
+			Reason: c.Kind.reason(),
+		})
+	}
+	return stderrors.Join(errs...)
+}
+
+const (
+	zeroWidthNonJoiner = '\u200C'
+	zeroWidthJoiner    = '\u200D'
+	blackFlag          = '\U0001F3F4'
+)
+
+// IsBidiControl reports whether r is a bidirectional formatting character:
+// the embeddings and overrides U+202A to U+202E, the isolates U+2066 to
+// U+2069, and the implicit marks LRM (U+200E), RLM (U+200F) and ALM
+// (U+061C). Such characters are the basis of "Trojan Source" attacks.
+func IsBidiControl(r rune) bool {
+	switch {
+	case r >= '\u202A' && r <= '\u202E', r >= '\u2066' && r <= '\u2069':
+		return true
+	case r == '\u200E', r == '\u200F', r == '\u061C':
+		return true
+	}
+	return false
+}
+
+// IsInvisible reports whether r renders without a visible glyph: zero width
+// spaces and joiners, the word joiner and invisible operators, the byte
+// order mark, the soft hyphen, fillers, tag characters and bidirectional
+// controls. Such characters can make two different strings look identical.
+func IsInvisible(r rune) bool {
+	switch {
+	case r >= '\u200B' && r <= '\u200F', r >= '\u2060' && r <= '\u2064':
+		return true
+	case r == '\u00AD', r == '\u034F', r == '\u180E', r == '\uFEFF':
+		return true
+	case r == '\u115F', r == '\u1160', r == '\u3164', r == '\uFFA0':
+		return true
+	case isTag(r), IsBidiControl(r):
+		return true
+	}
+	return false
+}
+
+func isTag(r rune) bool {
+	return r >= '\U000E0020' && r <= '\U000E007F'
+}
+
+// isJoinable reports whether a zero width joiner or non-joiner next to r may
+// be meaningful.
+func isJoinable(r rune) bool {
+	if r < utf8.RuneSelf || unicode.IsSpace(r) {
+		return false
+	}
+	return unicode.In(r, unicode.L, unicode.M, unicode.S)
+}
@@ -0,0 +1,98 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package model_test
+
+import (
+	stderrors "errors"
+	"reflect"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model"
+)
+
+func TestScanText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []model.UnsafeChar
+	}{
+		{"clean_ascii", "fix: handle nil\n\n\tindented", nil},
+		{"clean_unicode", "caf\u00e9 \u65b0\u6a5f\u80fd \u0645\u06cc\u200c\u062e\u0648\u0627\u0647\u0645 \U0001f468\u200d\U0001f469", nil},
+		{"clean_cyrillic_word", "\u043f\u0440\u0438\u0432\u0435\u0442 world", nil},
+		{"clean_crlf", "a\r\nb", nil},
+		{"clean_mixed_han_latin", "\u65b0feature", nil},
+		{
+			"bidi_override",
+			"ok \u202eevil",
+			[]model.UnsafeChar{{Kind: model.UnsafeBidi, Rune: '\u202e', Offset: 3, Line: 1, Column: 4}},
+		},
+		{
+			"position_on_later_line",
+			"fix: x\n\nab\u2066c",
+			[]model.UnsafeChar{{Kind: model.UnsafeBidi, Rune: '\u2066', Offset: 10, Line: 3, Column: 3}},
+		},
+		{
+			"zero_width_space_in_word",
+			"pay\u200bpal",
+			[]model.UnsafeChar{{Kind: model.UnsafeInvisible, Rune: '\u200b', Offset: 3, Line: 1, Column: 4}},
+		},
+		{
+			"ansi_escape",
+			"x\x1b[2Jy",
+			[]model.UnsafeChar{{Kind: model.UnsafeControl, Rune: '\x1b', Offset: 1, Line: 1, Column: 2}},
+		},
+		{
+			"homoglyph",
+			"p\u0430yp\u0430l login",
+			[]model.UnsafeChar{{Kind: model.UnsafeHomoglyph, Rune: '\u0430', Offset: 1, Line: 1, Column: 2}},
+		},
+		{
+			"smuggled_tags",
+			"ok\U000e0041",
+			[]model.UnsafeChar{{Kind: model.UnsafeInvisible, Rune: '\U000e0041', Offset: 2, Line: 1, Column: 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := model.ScanText(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScanText(%+q) = %+v, want %+v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckText(t *testing.T) {
+	if err := model.CheckText("Message", "feat: add search"); err != nil {
+		t.Errorf("CheckText() error = %v", err)
+	}
+
+	err := model.CheckText("Message", "fix: a\u202eb\n\u200bc")
+	if err == nil {
+		t.Fatal("CheckText() expected error")
+	}
+	var pe *errors.ParseError
+	if !stderrors.As(err, &pe) {
+		t.Fatalf("CheckText() error %T is not a *ParseError", err)
+	}
+	want := "dxapi: invalid Message value: U+202E at line 1, column 7: bidirectional control character\n" +
+		"dxapi: invalid Message value: U+200B at line 2, column 1: invisible character"
+	if err.Error() != want {
+		t.Errorf("CheckText() error = %q, want %q", err.Error(), want)
+	}
+}
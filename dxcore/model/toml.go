@@ -0,0 +1,118 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+)
+
+// MarshalTOML encodes v as a TOML document the way ToTOML does, without
+// validation. It accepts any value whose JSON form is an object, such as an
+// engine.Options configuration or a release plan.
+//
+// The TOML document is derived from the JSON form of v, so every type keeps
+// the textual representation it uses in JSON and YAML: enums such as
+// Strategy and change.Bump are written as their names (see their
+// MarshalText methods), hashes as hexadecimal strings and times as RFC 3339
+// strings. JSON null values are omitted, since TOML has no null.
+func MarshalTOML(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if _, ok := doc.(map[string]any); !ok {
+		return nil, fmt.Errorf("cannot marshal %T to TOML: a TOML document must be a table", v)
+	}
+	doc, err = tomlValue(doc)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal %T to TOML: %w", v, err)
+	}
+
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalTOML decodes a TOML document into v, which must be a non-nil
+// pointer. The document is converted to JSON and decoded with v's JSON
+// decoding, so it accepts what MarshalTOML produces; native TOML date-times
+// are accepted wherever an RFC 3339 string is. It does not validate the
+// result.
+func UnmarshalTOML(data []byte, v any) error {
+	var doc map[string]any
+	if err := toml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	js, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(js, v)
+}
+
+// tomlValue converts a value decoded from JSON into one the TOML encoder
+// accepts: numbers become int64 or float64 and null object members are
+// dropped.
+func tomlValue(v any) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, elem := range v {
+			if elem == nil {
+				continue
+			}
+			conv, err := tomlValue(elem)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			out[key] = conv
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(v))
+		for i, elem := range v {
+			if elem == nil {
+				return nil, fmt.Errorf("[%d]: TOML arrays cannot hold null", i)
+			}
+			conv, err := tomlValue(elem)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			out[i] = conv
+		}
+		return out, nil
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n, nil
+		}
+		return v.Float64()
+	default:
+		return v, nil
+	}
+}
@@ -0,0 +1,142 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package model_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+func TestTOML_RoundTrip(t *testing.T) {
+	msg, err := conventional.ParseMessage("feat(api,cli)!: add x\n\nbody\n\nRefs: #1\nBREAKING CHANGE: gone")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Commit", func(t *testing.T) { assertTOMLRoundTrip(t, codecCommit()) })
+	t.Run("Tag", func(t *testing.T) {
+		assertTOMLRoundTrip(t, &git.Tag{Name: "v1.0.0", Object: codecHash, Commit: codecHash, Annotated: true, Message: "Release"})
+	})
+	t.Run("CommitRange", func(t *testing.T) {
+		assertTOMLRoundTrip(t, &git.CommitRange{From: git.Ref{Name: "v1.0.0", Kind: git.RefKindTag}, To: git.Ref{Name: "HEAD"}})
+	})
+	t.Run("Message", func(t *testing.T) { assertTOMLRoundTrip(t, &msg) })
+}
+
+// assertTOMLRoundTrip encodes m as TOML, decodes it and checks that the
+// decoded value marshals to the same JSON as m.
+func assertTOMLRoundTrip[T model.Model](t *testing.T, m T) {
+	t.Helper()
+	want, err := model.ToJSON(m)
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	data, err := model.ToTOML(m)
+	if err != nil {
+		t.Fatalf("ToTOML() error = %v", err)
+	}
+	var got T
+	if err := model.FromTOML(data, &got); err != nil {
+		t.Fatalf("FromTOML() error = %v\n%s", err, data)
+	}
+	gotJSON, err := model.ToJSON(got)
+	if err != nil {
+		t.Fatalf("ToJSON(decoded) error = %v", err)
+	}
+	if !bytes.Equal(gotJSON, want) {
+		t.Errorf("TOML round trip changed JSON form:\n got %s\nwant %s", gotJSON, want)
+	}
+}
+
+func TestTOML_EnumNames(t *testing.T) {
+	type config struct {
+		Strategy model.Strategy           `json:"strategy"`
+		Mode     model.ClassificationMode `json:"mode"`
+		Bump     change.Bump              `json:"bump"`
+		Parents  []git.Hash               `json:"parents"`
+	}
+
+	data, err := model.MarshalTOML(config{Strategy: model.Sequential, Mode: model.ClassifySquash, Bump: change.BumpMinor})
+	if err != nil {
+		t.Fatalf("MarshalTOML() error = %v", err)
+	}
+	for _, want := range []string{`strategy = "sequential"`, `bump = "minor"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("MarshalTOML() = %s, want it to contain %s", data, want)
+		}
+	}
+	if strings.Contains(string(data), "parents") {
+		t.Errorf("MarshalTOML() = %s, want nil slice omitted", data)
+	}
+
+	var got config
+	if err := model.UnmarshalTOML(data, &got); err != nil {
+		t.Fatalf("UnmarshalTOML() error = %v", err)
+	}
+	if got.Strategy != model.Sequential || got.Mode != model.ClassifySquash || got.Bump != change.BumpMinor {
+		t.Errorf("UnmarshalTOML() = %+v", got)
+	}
+
+	if err := model.UnmarshalTOML([]byte(`strategy = "bogus"`), &got); err == nil {
+		t.Error("UnmarshalTOML() with unknown strategy succeeded, want error")
+	}
+}
+
+func TestFromTOML_NativeDateTime(t *testing.T) {
+	doc := `
+name = "Jane Doe"
+email = "jane@example.com"
+when = 2025-03-04T05:06:07+02:00
+`
+	var got *git.Signature
+	if err := model.FromTOML([]byte(doc), &got); err != nil {
+		t.Fatalf("FromTOML() error = %v", err)
+	}
+	if _, offset := got.When.Zone(); got.When.Hour() != 5 || offset != 2*60*60 {
+		t.Errorf("FromTOML() When = %v", got.When)
+	}
+}
+
+func TestTOML_Errors(t *testing.T) {
+	if _, err := model.ToTOML(&git.Commit{Hash: "not-a-hash"}); err == nil {
+		t.Error("ToTOML() of invalid commit succeeded, want error")
+	}
+
+	hash := codecHash
+	if _, err := model.ToTOML(&hash); err == nil {
+		t.Error("ToTOML() of a scalar model succeeded, want error")
+	}
+
+	if _, err := model.MarshalTOML([]json.RawMessage{json.RawMessage("null")}); err == nil {
+		t.Error("MarshalTOML() of an array succeeded, want error")
+	}
+
+	var c *git.Commit
+	if err := model.FromTOML([]byte("hash = "), &c); err == nil {
+		t.Error("FromTOML() of malformed TOML succeeded, want error")
+	}
+	if err := model.FromTOML([]byte(`hash = "xyz"`), &c); err == nil {
+		t.Error("FromTOML() of invalid commit succeeded, want error")
+	}
+}
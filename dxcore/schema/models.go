@@ -0,0 +1,252 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+
+	"dirpx.dev/dxrel/dxcore/engine"
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/model/semver"
+)
+
+// versionPattern is the regular expression recommended by the Semantic
+// Versioning 2.0.0 specification. Versions are marshaled without a "v"
+// prefix.
+const versionPattern = `^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
+
+// Patterns for constraints that Validate checks in code rather than with an
+// exported regular expression.
+const (
+	singleLinePattern   = `^[^\r\n]*$`
+	noCRPattern         = `^[^\r]*$`
+	relativePathPattern = `^[^/]`
+	nonBlankLinePattern = `^[^\r\n]*[^\s][^\r\n]*$`
+)
+
+func init() {
+	// git
+	register("Hash", git.Hash(""), func() *Schema {
+		s := str(0, 0, git.HashHexRegexp.String())
+		s.Description = "Lowercase hexadecimal object name: 40 characters for SHA-1, 64 for SHA-256."
+		return s
+	})
+	register("RefName", git.RefName(""), func() *Schema {
+		return str(git.RefNameMinLen, git.RefNameMaxLen, git.RefNameRegexp.String())
+	})
+	register("RefKind", git.RefKind(0), func() *Schema { return enum[git.RefKind]() })
+	register("Ref", git.Ref{}, func() *Schema {
+		s := object(map[string]*Schema{
+			"Name": orEmpty(ref("RefName")),
+			"Kind": ref("RefKind"),
+			"Hash": orEmpty(ref("Hash")),
+		}, "Name", "Kind", "Hash")
+		s.Description = "A reference. Ref has no JSON field tags, so its keys are capitalized; Name or Hash MUST be set."
+		s.AnyOf = []*Schema{
+			{Properties: map[string]*Schema{"Name": {MinLength: intPtr(1)}}},
+			{Properties: map[string]*Schema{"Hash": {MinLength: intPtr(1)}}},
+		}
+		return s
+	})
+	register("CommitRange", git.CommitRange{}, func() *Schema {
+		return object(map[string]*Schema{
+			"from": ref("Ref"),
+			"to":   ref("Ref"),
+		}, "from", "to")
+	})
+	register("TagName", git.TagName(""), func() *Schema {
+		return str(git.TagNameMinLen, git.TagNameMaxLen, git.TagNameRegexp.String())
+	})
+	register("Tag", git.Tag{}, func() *Schema {
+		return object(map[string]*Schema{
+			"name":      ref("TagName"),
+			"object":    ref("Hash"),
+			"commit":    ref("Hash"),
+			"annotated": {Type: Types{"boolean"}},
+			"message":   str(0, git.TagMessageMaxLen, ""),
+		}, "name", "object", "commit", "annotated")
+	})
+	register("Signature", git.Signature{}, func() *Schema {
+		email := str(1, git.SignatureEmailMaxLength, "")
+		email.Format = "email"
+		return object(map[string]*Schema{
+			"name":  str(1, git.SignatureNameMaxLength, ""),
+			"email": email,
+			"when":  {Type: Types{"string"}, Format: "date-time"},
+		}, "name", "email", "when")
+	})
+	register("FileChangeKind", git.FileChangeKind(0), func() *Schema { return enum[git.FileChangeKind]() })
+	register("FileChange", git.FileChange{}, func() *Schema {
+		return object(map[string]*Schema{
+			"path":     str(1, git.FilePathMaxLength, relativePathPattern),
+			"old_path": str(1, git.FilePathMaxLength, relativePathPattern),
+			"kind":     ref("FileChangeKind"),
+		}, "path", "kind")
+	})
+	register("Commit", git.Commit{}, func() *Schema {
+		return object(map[string]*Schema{
+			"hash":      ref("Hash"),
+			"parents":   nullable(array(ref("Hash"), git.CommitParentsMaxCount)),
+			"author":    ref("Signature"),
+			"committer": ref("Signature"),
+			"message":   str(1, git.CommitMessageMaxLen, noCRPattern),
+			"summary":   str(1, git.CommitSummaryMaxLen, singleLinePattern),
+			"changes":   nullable(array(ref("FileChange"), git.CommitChangesMaxCount)),
+		}, "hash", "parents", "author", "committer", "message", "summary", "changes")
+	})
+
+	// conventional
+	register("Type", conventional.Type(0), func() *Schema { return enum[conventional.Type]() })
+	register("Scope", conventional.Scope(""), func() *Schema {
+		return str(conventional.ScopeMinLen, conventional.ScopeMaxLen, conventional.ScopeRegexp.String())
+	})
+	register("Subject", conventional.Subject(""), func() *Schema {
+		return str(conventional.SubjectMinLen, conventional.SubjectMaxLen, nonBlankLinePattern)
+	})
+	register("Body", conventional.Body(""), func() *Schema {
+		s := str(0, conventional.BodyMaxBytes, noCRPattern)
+		s.Description = fmt.Sprintf("At most %d bytes of UTF-8 and %d lines.", conventional.BodyMaxBytes, conventional.BodyMaxLines)
+		return s
+	})
+	register("Trailer", conventional.Trailer{}, func() *Schema {
+		key := str(conventional.TrailerKeyMinLen, conventional.TrailerKeyMaxLen, "")
+		key.AnyOf = []*Schema{
+			{Pattern: conventional.TrailerKeyRegexp.String()},
+			{Const: conventional.BreakingChangeTrailerKey},
+		}
+		return object(map[string]*Schema{
+			"key":   key,
+			"value": str(0, conventional.TrailerValueMaxLen, singleLinePattern),
+		}, "key", "value")
+	})
+	register("Message", conventional.Message{}, func() *Schema {
+		scopes := array(ref("Scope"), 0)
+		scopes.MinItems = intPtr(2)
+		scopes.UniqueItems = true
+		return object(map[string]*Schema{
+			"type":     ref("Type"),
+			"scope":    ref("Scope"),
+			"scopes":   scopes,
+			"subject":  ref("Subject"),
+			"breaking": {Type: Types{"boolean"}},
+			"body":     ref("Body"),
+			"trailers": array(ref("Trailer"), 0),
+		}, "type", "subject")
+	})
+
+	// model, change and semver
+	register("Version", semver.Version{}, func() *Schema { return str(0, 0, versionPattern) })
+	register("Strategy", model.Strategy(0), func() *Schema { return enum[model.Strategy]() })
+	register("ClassificationMode", model.ClassificationMode(0), func() *Schema { return enum[model.ClassificationMode]() })
+	register("Bump", change.Bump(0), func() *Schema { return enum[change.Bump]() })
+
+	// engine
+	register("Step", engine.Step{}, func() *Schema {
+		return object(map[string]*Schema{
+			"commit": ref("Hash"),
+			"action": {Type: Types{"string"}, Enum: []any{
+				string(engine.ActionBump), string(engine.ActionCancel), string(engine.ActionSkip), string(engine.ActionReleaseAs),
+			}},
+			"bump":   ref("Bump"),
+			"reason": {Type: Types{"string"}},
+		}, "commit", "action", "bump", "reason")
+	})
+	register("Plan", engine.Plan{}, func() *Schema {
+		return object(map[string]*Schema{
+			"last":      ref("Version"),
+			"next":      ref("Version"),
+			"bump":      ref("Bump"),
+			"releaseAs": ref("Hash"),
+			"trace":     nullable(array(ref("Step"), 0)),
+		}, "last", "next", "bump", "trace")
+	})
+}
+
+// enumValue is an enum-like model type whose defined values are numbered
+// from zero and marshal as their String.
+type enumValue interface {
+	~int | ~uint8
+	Validate() error
+	String() string
+}
+
+// enum returns a string schema listing the String of every defined value
+// of T.
+func enum[T enumValue]() *Schema {
+	s := &Schema{Type: Types{"string"}}
+	for v := T(0); v.Validate() == nil; v++ {
+		s.Enum = append(s.Enum, v.String())
+	}
+	return s
+}
+
+func ref(name string) *Schema {
+	return &Schema{Ref: defsPrefix + name}
+}
+
+// str returns a string schema. Zero bounds and an empty pattern are omitted.
+func str(minLen, maxLen int, pattern string) *Schema {
+	s := &Schema{Type: Types{"string"}, Pattern: pattern}
+	if minLen > 0 {
+		s.MinLength = intPtr(minLen)
+	}
+	if maxLen > 0 {
+		s.MaxLength = intPtr(maxLen)
+	}
+	return s
+}
+
+// array returns an array schema. A zero maxItems is omitted.
+func array(items *Schema, maxItems int) *Schema {
+	s := &Schema{Type: Types{"array"}, Items: items}
+	if maxItems > 0 {
+		s.MaxItems = intPtr(maxItems)
+	}
+	return s
+}
+
+// object returns a closed object schema.
+func object(properties map[string]*Schema, required ...string) *Schema {
+	closed := false
+	return &Schema{
+		Type:                 Types{"object"},
+		Properties:           properties,
+		Required:             required,
+		AdditionalProperties: &closed,
+	}
+}
+
+// nullable also accepts null, which encoding/json emits for nil slices.
+func nullable(s *Schema) *Schema {
+	s.Type = append(s.Type, "null")
+	return s
+}
+
+// orEmpty also accepts the empty string, which optional string fields
+// without omitempty emit when unset.
+func orEmpty(s *Schema) *Schema {
+	return &Schema{AnyOf: []*Schema{s, {Const: ""}}}
+}
+
+func intPtr(n int) *int {
+	return &n
+}
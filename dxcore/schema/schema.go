@@ -0,0 +1,259 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package schema generates JSON Schema (draft 2020-12) documents for the dxrel
+// model types, so that editors can complete and check configuration files
+// and services consuming release plans can run contract tests against the
+// JSON that dxrel actually emits.
+//
+// Each type is described by a named definition. Definitions refer to one
+// another with "$ref": "#/$defs/<Name>", and For bundles the definition of a
+// type together with every definition it depends on. Packages that add
+// types to the dxrel surface, such as configuration or plan types, make them
+// available with Register.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Draft is the JSON Schema dialect of the generated documents.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// defsPrefix is the JSON pointer prefix of references to definitions.
+const defsPrefix = "#/$defs/"
+
+// Schema is a JSON Schema. Only the keywords dxrel needs are modeled; the
+// zero value accepts any instance.
+type Schema struct {
+	Schema      string             `json:"$schema,omitempty"`
+	ID          string             `json:"$id,omitempty"`
+	Ref         string             `json:"$ref,omitempty"`
+	Defs        map[string]*Schema `json:"$defs,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+
+	Type  Types     `json:"type,omitempty"`
+	Enum  []any     `json:"enum,omitempty"`
+	Const any       `json:"const,omitempty"`
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+
+	// String keywords.
+	MinLength *int   `json:"minLength,omitempty"`
+	MaxLength *int   `json:"maxLength,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+	Format    string `json:"format,omitempty"`
+
+	// Array keywords.
+	Items       *Schema `json:"items,omitempty"`
+	MinItems    *int    `json:"minItems,omitempty"`
+	MaxItems    *int    `json:"maxItems,omitempty"`
+	UniqueItems bool    `json:"uniqueItems,omitempty"`
+
+	// Object keywords.
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+}
+
+// Types is the value of the "type" keyword. A single type is encoded as a
+// string and several types as an array, for example ["array", "null"].
+type Types []string
+
+// MarshalJSON encodes a single type as a string.
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// UnmarshalJSON accepts a string or an array of strings.
+func (t *Types) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*t = Types{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return fmt.Errorf("schema type must be a string or an array of strings: %w", err)
+	}
+	*t = many
+	return nil
+}
+
+// Definition describes a registered type.
+type Definition struct {
+	// Name is the key of the definition under "$defs", conventionally the
+	// TypeName of the model.
+	Name string
+
+	// Type is the Go type the definition describes.
+	Type reflect.Type
+
+	// Build returns the schema of Type. It is called every time a document
+	// is generated and MUST return a new *Schema.
+	Build func() *Schema
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Definition{}
+	byType     = map[reflect.Type]string{}
+)
+
+// Register adds def to the registry, replacing any definition registered
+// under the same name or for the same type. It is safe for concurrent use.
+func Register(def Definition) error {
+	if def.Name == "" || def.Type == nil || def.Build == nil {
+		return fmt.Errorf("schema definition must have a name, a type and a builder")
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if old, ok := registry[def.Name]; ok {
+		delete(byType, old.Type)
+	}
+	if old, ok := byType[def.Type]; ok {
+		delete(registry, old)
+	}
+	registry[def.Name] = def
+	byType[def.Type] = def.Name
+	return nil
+}
+
+// register is Register for the built-in definitions of this package.
+func register(name string, v any, build func() *Schema) {
+	if err := Register(Definition{Name: name, Type: reflect.TypeOf(v), Build: build}); err != nil {
+		panic(err)
+	}
+}
+
+// Names returns the names of all registered definitions in sorted order.
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup returns the schema of the definition registered under name.
+func Lookup(name string) (*Schema, bool) {
+	registryMu.RLock()
+	def, ok := registry[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return def.Build(), true
+}
+
+// For returns a self-contained document for the type of v (or the type v
+// points to): a "$ref" to its definition, and "$defs" holding that
+// definition and every definition it refers to. It returns an error if the
+// type, or a definition it refers to, is not registered.
+func For(v any) (*Schema, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	registryMu.RLock()
+	name, ok := byType[t]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no schema registered for %v", t)
+	}
+	return ForName(name)
+}
+
+// ForName is like For for the definition registered under name.
+func ForName(name string) (*Schema, error) {
+	defs, err := closure([]string{name})
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{Schema: Draft, Ref: defsPrefix + name, Defs: defs}, nil
+}
+
+// Document returns a document holding every registered definition under
+// "$defs" and no root constraint. Tools that need a single file describing
+// the whole dxrel surface SHOULD use it.
+func Document() (*Schema, error) {
+	defs, err := closure(Names())
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{Schema: Draft, Defs: defs}, nil
+}
+
+// closure builds the named definitions and every definition they refer to.
+func closure(names []string) (map[string]*Schema, error) {
+	defs := map[string]*Schema{}
+	for len(names) > 0 {
+		name := names[0]
+		names = names[1:]
+		if _, done := defs[name]; done {
+			continue
+		}
+		s, ok := Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("no schema registered under %q", name)
+		}
+		defs[name] = s
+		s.walk(func(n *Schema) {
+			if ref, ok := strings.CutPrefix(n.Ref, defsPrefix); ok {
+				names = append(names, ref)
+			}
+		})
+	}
+	return defs, nil
+}
+
+// walk calls fn for s and every schema nested in it.
+func (s *Schema) walk(fn func(*Schema)) {
+	if s == nil {
+		return
+	}
+	fn(s)
+	for _, name := range sortedKeys(s.Properties) {
+		s.Properties[name].walk(fn)
+	}
+	for _, name := range sortedKeys(s.Defs) {
+		s.Defs[name].walk(fn)
+	}
+	for _, sub := range s.AnyOf {
+		sub.walk(fn)
+	}
+	s.Items.walk(fn)
+}
+
+func sortedKeys(m map[string]*Schema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,359 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package schema_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"dirpx.dev/dxrel/dxcore/engine"
+	"dirpx.dev/dxrel/dxcore/model"
+	"dirpx.dev/dxrel/dxcore/model/change"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/model/semver"
+	"dirpx.dev/dxrel/dxcore/schema"
+)
+
+const hash = git.Hash("a1b2c3d4e5f67890abcdef1234567890abcdef12")
+
+// check validates the JSON instance against doc, supporting the keywords
+// the generator emits, and returns the first violation found.
+func check(doc *schema.Schema, s *schema.Schema, v any, path string) error {
+	if s.Ref != "" {
+		def, ok := doc.Defs[strings.TrimPrefix(s.Ref, "#/$defs/")]
+		if !ok {
+			return fmt.Errorf("%s: unresolved $ref %s", path, s.Ref)
+		}
+		if err := check(doc, def, v, path); err != nil {
+			return err
+		}
+	}
+	if len(s.Type) > 0 && !hasType(s.Type, v) {
+		return fmt.Errorf("%s: %v is not of type %v", path, v, s.Type)
+	}
+	if s.Enum != nil && !contains(s.Enum, v) {
+		return fmt.Errorf("%s: %v is not one of %v", path, v, s.Enum)
+	}
+	if s.Const != nil && !reflect.DeepEqual(s.Const, v) {
+		return fmt.Errorf("%s: %v is not %v", path, v, s.Const)
+	}
+	if len(s.AnyOf) > 0 {
+		matched := false
+		for _, sub := range s.AnyOf {
+			if check(doc, sub, v, path) == nil {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%s: %v matches no anyOf branch", path, v)
+		}
+	}
+	switch v := v.(type) {
+	case string:
+		n := utf8.RuneCountInString(v)
+		if s.MinLength != nil && n < *s.MinLength {
+			return fmt.Errorf("%s: %q is shorter than %d", path, v, *s.MinLength)
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			return fmt.Errorf("%s: %q is longer than %d", path, v, *s.MaxLength)
+		}
+		if s.Pattern != "" && !regexp.MustCompile(s.Pattern).MatchString(v) {
+			return fmt.Errorf("%s: %q does not match %s", path, v, s.Pattern)
+		}
+	case []any:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			return fmt.Errorf("%s: fewer than %d items", path, *s.MinItems)
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			return fmt.Errorf("%s: more than %d items", path, *s.MaxItems)
+		}
+		for i, item := range v {
+			if s.Items != nil {
+				if err := check(doc, s.Items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+			if s.UniqueItems && contains(v[:i], item) {
+				return fmt.Errorf("%s: duplicate item %v", path, item)
+			}
+		}
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				return fmt.Errorf("%s: missing required property %q", path, name)
+			}
+		}
+		for name, value := range v {
+			prop, ok := s.Properties[name]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					return fmt.Errorf("%s: unexpected property %q", path, name)
+				}
+				continue
+			}
+			if err := check(doc, prop, value, path+"."+name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func hasType(types schema.Types, v any) bool {
+	for _, typ := range types {
+		switch v.(type) {
+		case nil:
+			if typ == "null" {
+				return true
+			}
+		case bool:
+			if typ == "boolean" {
+				return true
+			}
+		case float64:
+			if typ == "number" || typ == "integer" {
+				return true
+			}
+		case string:
+			if typ == "string" {
+				return true
+			}
+		case []any:
+			if typ == "array" {
+				return true
+			}
+		case map[string]any:
+			if typ == "object" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func contains(list []any, v any) bool {
+	for _, x := range list {
+		if reflect.DeepEqual(x, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// validate marshals v and checks it against the schema generated for v,
+// after a JSON round trip of the schema itself.
+func validate(t *testing.T, v any) error {
+	t.Helper()
+	doc, err := schema.For(v)
+	if err != nil {
+		t.Fatalf("For(%T) error = %v", v, err)
+	}
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("json.Marshal(schema) error = %v", err)
+	}
+	var decoded schema.Schema
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(schema) error = %v", err)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal(%T) error = %v", v, err)
+	}
+	var instance any
+	if err := json.Unmarshal(data, &instance); err != nil {
+		t.Fatal(err)
+	}
+	return check(&decoded, &decoded, instance, "$")
+}
+
+func TestFor_AcceptsMarshaledModels(t *testing.T) {
+	sig := git.Signature{Name: "Jane", Email: "jane@example.com", When: time.Now()}
+	msg, err := conventional.ParseMessage("feat(api,cli)!: add x\n\nbody\n\nRefs: #1\nBREAKING CHANGE: gone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := semver.Version{Major: 1}
+	plan, err := engine.PlanRelease(last, []git.Commit{
+		{Hash: hash, Message: "feat: add x"},
+		{Hash: "b1b2c3d4e5f67890abcdef1234567890abcdef12", Message: "fix: y\n\nRelease-As: 3.0.0-rc.1+build.5"},
+	}, engine.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []any{
+		hash,
+		git.Ref{Name: "refs/heads/main", Kind: git.RefKindBranch, Hash: hash},
+		git.Ref{Hash: hash},
+		git.Ref{Name: "main"},
+		git.CommitRange{From: git.Ref{Name: "v1.0.0", Kind: git.RefKindTag}, To: git.Ref{Name: "HEAD"}},
+		git.Tag{Name: "v1.0.0", Object: hash, Commit: hash, Annotated: true, Message: "Release"},
+		git.Tag{Name: "v1.0.0", Object: hash, Commit: hash},
+		git.Commit{Hash: hash, Author: sig, Committer: sig, Message: "fix: bug\n\nbody", Summary: "fix: bug"},
+		git.Commit{
+			Hash: hash, Parents: []git.Hash{hash}, Author: sig, Committer: sig, Message: "x", Summary: "x",
+			Changes: []git.FileChange{{Path: "a.go", Kind: git.FileChangeAdded}, {Path: "b.go", OldPath: "c.go", Kind: git.FileChangeRenamed}},
+		},
+		msg,
+		conventional.Message{Type: conventional.Fix, Subject: "x"},
+		semver.Version{Major: 1, Minor: 2, Patch: 3},
+		model.Sequential,
+		model.ClassifySquash,
+		change.BumpMinor,
+		plan,
+	}
+	for _, v := range tests {
+		t.Run(fmt.Sprintf("%T", v), func(t *testing.T) {
+			if err := validate(t, v); err != nil {
+				t.Errorf("schema rejects %+v: %v", v, err)
+			}
+		})
+	}
+}
+
+func TestFor_RejectsInvalidInstances(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        any
+		instance string
+	}{
+		{"short hash", hash, `"a1b2c3"`},
+		{"uppercase hash", hash, `"A1B2C3D4E5F67890ABCDEF1234567890ABCDEF12"`},
+		{"unknown bump", change.BumpNone, `"huge"`},
+		{"uppercase scope", conventional.Message{}, `{"type":"fix","scope":"API","subject":"x"}`},
+		{"unknown type", conventional.Message{}, `{"type":"feature","subject":"x"}`},
+		{"missing subject", conventional.Message{}, `{"type":"fix"}`},
+		{"long subject", conventional.Message{}, `{"type":"fix","subject":"` + strings.Repeat("x", conventional.SubjectMaxLen+1) + `"}`},
+		{"single scopes entry", conventional.Message{}, `{"type":"fix","scope":"a","scopes":["a"],"subject":"x"}`},
+		{"unknown field", conventional.Message{}, `{"type":"fix","subject":"x","colour":"red"}`},
+		{"empty ref", git.Ref{}, `{"Name":"","Kind":"unknown","Hash":""}`},
+		{"v prefix", semver.Version{}, `"v1.2.3"`},
+		{"absolute path", git.FileChange{}, `{"path":"/etc","kind":"added"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := schema.For(tt.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var instance any
+			if err := json.Unmarshal([]byte(tt.instance), &instance); err != nil {
+				t.Fatal(err)
+			}
+			if check(doc, doc, instance, "$") == nil {
+				t.Errorf("schema accepts %s", tt.instance)
+			}
+		})
+	}
+}
+
+func TestFor_Bundle(t *testing.T) {
+	doc, err := schema.For(&git.Commit{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.Schema != schema.Draft || doc.Ref != "#/$defs/Commit" {
+		t.Errorf("For() = {$schema: %q, $ref: %q}", doc.Schema, doc.Ref)
+	}
+	var names []string
+	for name := range doc.Defs {
+		names = append(names, name)
+	}
+	for _, want := range []string{"Commit", "Hash", "Signature", "FileChange", "FileChangeKind"} {
+		if doc.Defs[want] == nil {
+			t.Errorf("For(Commit) $defs = %v, missing %s", names, want)
+		}
+	}
+	if doc.Defs["Message"] != nil {
+		t.Error("For(Commit) must only bundle the definitions it refers to")
+	}
+
+	if _, err := schema.For(struct{}{}); err == nil {
+		t.Error("For(unregistered) must fail")
+	}
+}
+
+func TestFor_EnumsMatchModels(t *testing.T) {
+	tests := []struct {
+		v    any
+		want []any
+	}{
+		{change.BumpNone, []any{"none", "patch", "minor", "major"}},
+		{model.MaxSeverity, []any{"max-severity", "sequential"}},
+	}
+	for _, tt := range tests {
+		doc, err := schema.For(tt.v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		def := doc.Defs[strings.TrimPrefix(doc.Ref, "#/$defs/")]
+		if !reflect.DeepEqual(def.Enum, tt.want) {
+			t.Errorf("enum of %T = %v, want %v", tt.v, def.Enum, tt.want)
+		}
+	}
+
+	doc, _ := schema.For(conventional.Type(0))
+	if got := len(doc.Defs["Type"].Enum); got == 0 || doc.Defs["Type"].Enum[0] != conventional.Feat.String() {
+		t.Errorf("Type enum = %v", doc.Defs["Type"].Enum)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	type Config struct {
+		Strategy model.Strategy `json:"strategy"`
+	}
+	err := schema.Register(schema.Definition{
+		Name: "TestConfig",
+		Type: reflect.TypeOf(Config{}),
+		Build: func() *schema.Schema {
+			return &schema.Schema{
+				Type:       schema.Types{"object"},
+				Properties: map[string]*schema.Schema{"strategy": {Ref: "#/$defs/Strategy"}},
+			}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := validate(t, Config{Strategy: model.Sequential}); err != nil {
+		t.Errorf("schema rejects config: %v", err)
+	}
+
+	doc, err := schema.Document()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range schema.Names() {
+		if doc.Defs[name] == nil {
+			t.Errorf("Document() is missing %s", name)
+		}
+	}
+
+	if err := schema.Register(schema.Definition{Name: "Broken"}); err == nil {
+		t.Error("Register() must reject incomplete definitions")
+	}
+}
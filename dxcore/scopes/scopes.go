@@ -0,0 +1,207 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package scopes infers Conventional Commit scopes from the files a commit
+// changes. Repositories describe their layout with path-to-scope rules, for
+// example "cli/" -> cli and "internal/api/" -> api; Infer then reports which
+// scopes a set of git.FileChange values touches.
+package scopes
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+// Rule maps repository paths to a scope.
+//
+// Pattern is either a directory ("cli" or "cli/"), matching every file below
+// it, or a path.Match glob ("cmd/*", "*.proto"). A glob matches a file if it
+// matches the file's path or any of its parent directories, so "cmd/*"
+// matches "cmd/dxrel/main.go".
+type Rule struct {
+	// Pattern selects the paths the rule applies to.
+	Pattern string `json:"pattern" yaml:"pattern"`
+
+	// Scope is the scope of the matched paths.
+	Scope conventional.Scope `json:"scope" yaml:"scope"`
+}
+
+// Validate checks that Pattern is a non-empty, well-formed relative pattern
+// and that Scope is a valid, non-empty scope.
+func (r Rule) Validate() error {
+	return errors.RunChecks(false, r.checks()...)
+}
+
+// ValidateFull is like Validate, but instead of stopping at the first
+// problem it reports one error per invalid field, joined with errors.Join
+// (see errors.Violations).
+func (r Rule) ValidateFull() error {
+	return errors.RunChecks(true, r.checks()...)
+}
+
+// checks returns the validation steps of Validate, one per field.
+func (r Rule) checks() []errors.Check {
+	return []errors.Check{
+		// Validate Pattern
+		func() error {
+			if r.Pattern == "" {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   "Rule",
+					Field:  "Pattern",
+					Reason: "scope rule pattern cannot be empty",
+				}
+			}
+			if strings.HasPrefix(r.Pattern, "/") {
+				return &errors.ValidationError{
+					Code:   errors.CodeFormat,
+					Type:   "Rule",
+					Field:  "Pattern",
+					Reason: fmt.Sprintf("scope rule pattern %q must be relative to the repository root", r.Pattern),
+					Value:  r.Pattern,
+				}
+			}
+			if _, err := path.Match(r.Pattern, ""); err != nil {
+				return &errors.ValidationError{
+					Code:   errors.CodeFormat,
+					Type:   "Rule",
+					Field:  "Pattern",
+					Reason: fmt.Sprintf("scope rule pattern %q is malformed: %v", r.Pattern, err),
+					Value:  r.Pattern,
+					Err:    err,
+				}
+			}
+			return nil
+		},
+		// Validate Scope
+		func() error {
+			if r.Scope.IsZero() {
+				return &errors.ValidationError{
+					Code:   errors.CodeRequired,
+					Type:   "Rule",
+					Field:  "Scope",
+					Reason: fmt.Sprintf("scope rule %q has no scope", r.Pattern),
+				}
+			}
+			if err := r.Scope.Validate(); err != nil {
+				return errors.Nest(err, "Rule", "Scope")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateRules validates every rule fully and returns all violations
+// joined with errors.Join, so that a configuration listing several broken
+// rules is reported at once. Paths are rooted at "Rules", for example
+// "Rules[2].Pattern". It returns nil if every rule is valid.
+func ValidateRules(rules []Rule) error {
+	return errors.Each(true, len(rules), func(i int) error {
+		return errors.Nest(rules[i].ValidateFull(), "Rules", fmt.Sprintf("[%d]", i))
+	})
+}
+
+// Match reports whether the rule applies to the repository path p.
+func (r Rule) Match(p string) bool {
+	if !strings.ContainsAny(r.Pattern, "*?[") {
+		dir := strings.TrimSuffix(r.Pattern, "/")
+		return p == dir || strings.HasPrefix(p, dir+"/")
+	}
+	for candidate := p; candidate != "." && candidate != "/" && candidate != ""; candidate = path.Dir(candidate) {
+		if ok, _ := path.Match(r.Pattern, candidate); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Scope returns the scope of the first rule matching p, and reports whether
+// any rule matched.
+func Scope(p string, rules []Rule) (conventional.Scope, bool) {
+	for _, r := range rules {
+		if r.Match(p) {
+			return r.Scope, true
+		}
+	}
+	return "", false
+}
+
+// Count is the number of changed files attributed to a scope.
+type Count struct {
+	Scope conventional.Scope
+	Files int
+}
+
+// Infer returns the scopes touched by changes, ordered by the number of
+// files attributed to them (most first) and then by name. Each file is
+// attributed to the scope of the first matching rule; renames and copies
+// also count their old path. Files that match no rule are ignored, so
+// Infer returns nil when no changed file is covered by the rules.
+func Infer(changes []git.FileChange, rules []Rule) []Count {
+	counts := make(map[conventional.Scope]int)
+	for _, fc := range changes {
+		seen := make(map[conventional.Scope]bool, 2)
+		for _, p := range []string{fc.Path, fc.OldPath} {
+			if p == "" {
+				continue
+			}
+			if s, ok := Scope(p, rules); ok && !seen[s] {
+				seen[s] = true
+				counts[s]++
+			}
+		}
+	}
+
+	if len(counts) == 0 {
+		return nil
+	}
+	result := make([]Count, 0, len(counts))
+	for s, n := range counts {
+		result = append(result, Count{Scope: s, Files: n})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Files != result[j].Files {
+			return result[i].Files > result[j].Files
+		}
+		return result[i].Scope < result[j].Scope
+	})
+	return result
+}
+
+// Suggest returns the scope most of changes belong to, and reports false if
+// no changed file is covered by the rules.
+func Suggest(changes []git.FileChange, rules []Rule) (conventional.Scope, bool) {
+	counts := Infer(changes, rules)
+	if len(counts) == 0 {
+		return "", false
+	}
+	return counts[0].Scope, true
+}
+
+// Covers reports whether the declared scope accounts for the inferred scope.
+// Scopes are hierarchical: "core" covers "core/io" and vice versa, since a
+// commit scoped to a parent or a child of the touched component is still
+// consistent with it.
+func Covers(declared, inferred conventional.Scope) bool {
+	d, i := string(declared), string(inferred)
+	return d == i || strings.HasPrefix(i, d+"/") || strings.HasPrefix(d, i+"/")
+}
@@ -0,0 +1,151 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package scopes_test
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/errors"
+	"dirpx.dev/dxrel/dxcore/model/conventional"
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/scopes"
+)
+
+var rules = []scopes.Rule{
+	{Pattern: "internal/api/", Scope: "api"},
+	{Pattern: "cli", Scope: "cli"},
+	{Pattern: "core/io", Scope: "core/io"},
+	{Pattern: "core", Scope: "core"},
+	{Pattern: "*.proto", Scope: "proto"},
+	{Pattern: "cmd/*", Scope: "cli"},
+}
+
+func change(p string) git.FileChange {
+	return git.FileChange{Path: p, Kind: git.FileChangeModified}
+}
+
+func TestRule_Match(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"cli", "cli/main.go", true},
+		{"cli/", "cli/sub/x.go", true},
+		{"cli", "client/main.go", false},
+		{"cmd/*", "cmd/dxrel/main.go", true},
+		{"cmd/*", "cmd", false},
+		{"*.proto", "api.proto", true},
+		{"*.proto", "api/v1/api.proto", false},
+	}
+	for _, tt := range tests {
+		r := scopes.Rule{Pattern: tt.pattern, Scope: "x"}
+		if got := r.Match(tt.path); got != tt.want {
+			t.Errorf("Rule{%q}.Match(%q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestRule_Validate(t *testing.T) {
+	invalid := []scopes.Rule{
+		{Pattern: "", Scope: "x"},
+		{Pattern: "/abs", Scope: "x"},
+		{Pattern: "[", Scope: "x"},
+		{Pattern: "cli"},
+		{Pattern: "cli", Scope: "Bad Scope"},
+	}
+	for _, r := range invalid {
+		if err := r.Validate(); err == nil {
+			t.Errorf("Validate(%+v) = nil, want error", r)
+		}
+	}
+	for _, r := range rules {
+		if err := r.Validate(); err != nil {
+			t.Errorf("Validate(%+v) error = %v", r, err)
+		}
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	if err := scopes.ValidateRules(rules); err != nil {
+		t.Fatalf("ValidateRules() = %v, want nil", err)
+	}
+
+	bad := []scopes.Rule{
+		{Pattern: "cli", Scope: "cli"},
+		{Pattern: "/abs", Scope: "Bad Scope"},
+		{Pattern: "", Scope: "api"},
+	}
+	got := errors.Violations(scopes.ValidateRules(bad))
+	want := []string{"Rules[1].Pattern", "Rules[1].Scope", "Rules[2].Pattern"}
+	if len(got) != len(want) {
+		t.Fatalf("ValidateRules() reported %d violations, want %d: %v", len(got), len(want), got)
+	}
+	for i, err := range got {
+		var ve *errors.ValidationError
+		if !stderrors.As(err, &ve) || ve.Path() != want[i] {
+			t.Errorf("violation %d = %v, want path %q", i, err, want[i])
+		}
+	}
+}
+
+func TestInfer(t *testing.T) {
+	changes := []git.FileChange{
+		change("cli/main.go"),
+		change("cmd/dxrel/main.go"),
+		change("core/io/reader.go"),
+		change("README.md"),
+		{Path: "internal/api/new.go", OldPath: "cli/old.go", Kind: git.FileChangeRenamed},
+	}
+
+	got := scopes.Infer(changes, rules)
+	want := []scopes.Count{{"cli", 3}, {"api", 1}, {"core/io", 1}}
+	if len(got) != len(want) {
+		t.Fatalf("Infer() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Infer()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if s, ok := scopes.Suggest(changes, rules); !ok || s != "cli" {
+		t.Errorf("Suggest() = (%q, %v), want (cli, true)", s, ok)
+	}
+	if _, ok := scopes.Suggest([]git.FileChange{change("README.md")}, rules); ok {
+		t.Error("Suggest() reported a scope for uncovered files")
+	}
+}
+
+func TestCovers(t *testing.T) {
+	tests := []struct {
+		declared, inferred conventional.Scope
+		want               bool
+	}{
+		{"api", "api", true},
+		{"core", "core/io", true},
+		{"core/io", "core", true},
+		{"core", "corelib", false},
+		{"api", "cli", false},
+	}
+	for _, tt := range tests {
+		if got := scopes.Covers(tt.declared, tt.inferred); got != tt.want {
+			t.Errorf("Covers(%q, %q) = %v, want %v", tt.declared, tt.inferred, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package verify
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgperrors "github.com/ProtonMail/go-crypto/openpgp/errors"
+
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+// OpenPGP verifies OpenPGP signatures against a fixed set of public keys.
+type OpenPGP struct {
+	keys openpgp.EntityList
+}
+
+// NewOpenPGP returns a Verifier that trusts the public keys of an
+// ASCII-armored keyring, as exported by "gpg --armor --export". Keys that are
+// revoked or expired at verification time are not trusted.
+func NewOpenPGP(armored io.Reader) (*OpenPGP, error) {
+	keys, err := openpgp.ReadArmoredKeyRing(armored)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read OpenPGP keyring: %w", err)
+	}
+	return &OpenPGP{keys: keys}, nil
+}
+
+// Verify implements Verifier.
+func (v *OpenPGP) Verify(obj git.SignedObject) (Result, error) {
+	if !obj.IsSigned() {
+		return Result{}, ErrUnsigned
+	}
+	if obj.Format != git.SignatureOpenPGP {
+		return Result{}, fmt.Errorf("%w: %s", ErrUnsupportedFormat, obj.Format)
+	}
+
+	signer, err := openpgp.CheckArmoredDetachedSignature(v.keys,
+		bytes.NewReader(obj.Payload), bytes.NewReader(obj.Signature), nil)
+	if err != nil {
+		var sigErr pgperrors.SignatureError
+		switch {
+		case errors.Is(err, pgperrors.ErrUnknownIssuer),
+			errors.Is(err, pgperrors.ErrKeyRevoked),
+			errors.Is(err, pgperrors.ErrKeyExpired),
+			errors.Is(err, pgperrors.ErrSignatureExpired):
+			return Result{}, fmt.Errorf("%w: %v", ErrUntrustedKey, err)
+		case errors.As(err, &sigErr):
+			return Result{}, fmt.Errorf("%w: %v", ErrBadSignature, err)
+		default:
+			return Result{}, fmt.Errorf("cannot verify OpenPGP signature: %w", err)
+		}
+	}
+
+	res := Result{
+		Format:      git.SignatureOpenPGP,
+		Fingerprint: strings.ToUpper(hex.EncodeToString(signer.PrimaryKey.Fingerprint)),
+	}
+	if id := signer.PrimaryIdentity(); id != nil {
+		res.Signer = id.Name
+	}
+	return res, nil
+}
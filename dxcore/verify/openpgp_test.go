@@ -0,0 +1,109 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package verify_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/verify"
+)
+
+// otherPGPKey returns the armored public key of a fresh OpenPGP key.
+func otherPGPKey(t *testing.T) string {
+	t.Helper()
+	e, err := openpgp.NewEntity("John Roe", "", "john@example.com", nil)
+	if err != nil {
+		t.Fatalf("NewEntity() error = %v", err)
+	}
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatalf("armor.Encode() error = %v", err)
+	}
+	if err := e.Serialize(w); err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	return buf.String()
+}
+
+func TestOpenPGP_Verify(t *testing.T) {
+	v := newOpenPGP(t, pgpPublicKey)
+
+	for name, obj := range map[string]git.SignedObject{
+		"commit": mustParse(t, git.ParseSignedCommit, pgpCommit),
+		"tag":    mustParse(t, git.ParseSignedTag, pgpTag),
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := v.Verify(obj)
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			want := verify.Result{
+				Format:      git.SignatureOpenPGP,
+				Fingerprint: pgpFingerprint,
+				Signer:      "Jane Doe <jane@example.com>",
+			}
+			if res != want {
+				t.Errorf("Verify() = %+v, want %+v", res, want)
+			}
+		})
+	}
+}
+
+func TestOpenPGP_Verify_Errors(t *testing.T) {
+	good := mustParse(t, git.ParseSignedCommit, pgpCommit)
+
+	tampered := good
+	tampered.Payload = bytes.Replace(good.Payload, []byte("fix: add b"), []byte("fix: add c"), 1)
+
+	tests := []struct {
+		name    string
+		keys    string
+		obj     git.SignedObject
+		wantErr error
+	}{
+		{name: "tampered", keys: pgpPublicKey, obj: tampered, wantErr: verify.ErrBadSignature},
+		{name: "untrusted", keys: otherPGPKey(t), obj: good, wantErr: verify.ErrUntrustedKey},
+		{name: "ssh", keys: pgpPublicKey, obj: mustParse(t, git.ParseSignedCommit, sshCommit), wantErr: verify.ErrUnsupportedFormat},
+		{name: "unsigned", keys: pgpPublicKey, obj: git.SignedObject{Payload: good.Payload}, wantErr: verify.ErrUnsigned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newOpenPGP(t, tt.keys).Verify(tt.obj)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewOpenPGP_Invalid(t *testing.T) {
+	if _, err := verify.NewOpenPGP(strings.NewReader("not a key")); err == nil {
+		t.Error("NewOpenPGP() succeeded, want error")
+	}
+}
@@ -0,0 +1,125 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package verify
+
+import (
+	"errors"
+	"fmt"
+
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+// ObjectReader reads raw git objects, as printed by
+// "git cat-file <type> <hash>". Signatures are checked against the raw
+// objects because the parsed git.Commit and git.Tag do not keep the exact
+// bytes that were signed.
+type ObjectReader interface {
+	ReadObject(hash git.Hash) ([]byte, error)
+}
+
+// Policy is the signing policy of a release.
+type Policy struct {
+	// Verifier decides which keys are trusted.
+	Verifier Verifier
+
+	// RequireSignedCommits requires every commit of the release range to be
+	// signed by a trusted key.
+	RequireSignedCommits bool
+
+	// RequireSignedTag requires the release tag to be an annotated tag
+	// signed by a trusted key.
+	RequireSignedTag bool
+}
+
+// Violation is a release object that does not satisfy a Policy.
+type Violation struct {
+	// Type is the git object type, "commit" or "tag".
+	Type string
+
+	// Object is the hash of the offending object.
+	Object git.Hash
+
+	// Err is the reason, which wraps one of the sentinel errors of this
+	// package when the object was read and parsed.
+	Err error
+}
+
+// Error implements the error interface.
+func (v *Violation) Error() string {
+	return fmt.Sprintf("%s %s: %v", v.Type, v.Object.Short(), v.Err)
+}
+
+// Unwrap returns the reason of the violation.
+func (v *Violation) Unwrap() error {
+	return v.Err
+}
+
+// Check applies the policy to a release: its tag and the commits of its
+// range. It returns nil when the release satisfies the policy, or the
+// *Violation of every offending object joined with errors.Join.
+func (p Policy) Check(r ObjectReader, tag git.Tag, commits []git.Commit) error {
+	return errors.Join(p.CheckTag(r, tag), p.CheckCommits(r, commits))
+}
+
+// CheckCommits checks every commit against the policy. It returns nil when
+// RequireSignedCommits is false.
+func (p Policy) CheckCommits(r ObjectReader, commits []git.Commit) error {
+	if !p.RequireSignedCommits {
+		return nil
+	}
+	var errs []error
+	for _, c := range commits {
+		if err := p.verify(r, "commit", c.Hash, git.ParseSignedCommit); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CheckTag checks the release tag against the policy. It returns nil when
+// RequireSignedTag is false. A lightweight tag cannot be signed and always
+// violates the policy.
+func (p Policy) CheckTag(r ObjectReader, tag git.Tag) error {
+	if !p.RequireSignedTag {
+		return nil
+	}
+	if !tag.Annotated {
+		return &Violation{Type: "tag", Object: tag.Object, Err: fmt.Errorf("%w: %s is a lightweight tag", ErrUnsigned, tag.Name)}
+	}
+	return p.verify(r, "tag", tag.Object, git.ParseSignedTag)
+}
+
+func (p Policy) verify(r ObjectReader, typ string, hash git.Hash, parse func([]byte) (git.SignedObject, error)) error {
+	raw, err := r.ReadObject(hash)
+	if err != nil {
+		return &Violation{Type: typ, Object: hash, Err: err}
+	}
+	obj, err := parse(raw)
+	if err != nil {
+		return &Violation{Type: typ, Object: hash, Err: err}
+	}
+	if !obj.IsSigned() {
+		return &Violation{Type: typ, Object: hash, Err: ErrUnsigned}
+	}
+	if p.Verifier == nil {
+		return &Violation{Type: typ, Object: hash, Err: fmt.Errorf("%w: no trusted keys", ErrUntrustedKey)}
+	}
+	if _, err := p.Verifier.Verify(obj); err != nil {
+		return &Violation{Type: typ, Object: hash, Err: err}
+	}
+	return nil
+}
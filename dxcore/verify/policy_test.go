@@ -0,0 +1,147 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package verify_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/verify"
+)
+
+// objects is an in-memory verify.ObjectReader.
+type objects map[git.Hash]string
+
+func (o objects) ReadObject(hash git.Hash) ([]byte, error) {
+	raw, ok := o[hash]
+	if !ok {
+		return nil, fmt.Errorf("object %s not found", hash)
+	}
+	return []byte(raw), nil
+}
+
+func mustHash(t *testing.T, s string) git.Hash {
+	t.Helper()
+	h, err := git.ParseHash(s)
+	if err != nil {
+		t.Fatalf("ParseHash(%q) error = %v", s, err)
+	}
+	return h
+}
+
+// violations returns the objects of the violations joined in err, in order.
+func violations(t *testing.T, err error) []git.Hash {
+	t.Helper()
+	if v, ok := err.(*verify.Violation); ok {
+		return []git.Hash{v.Object}
+	}
+	var hashes []git.Hash
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			hashes = append(hashes, violations(t, e)...)
+		}
+	} else if err != nil {
+		t.Fatalf("Check() error %v is not a *Violation", err)
+	}
+	return hashes
+}
+
+func TestPolicy_Check(t *testing.T) {
+	sshCommitHash := mustHash(t, "9ff2ebb8e719cb7019ee5a046fca22d05acf8de0")
+	pgpCommitHash := mustHash(t, "c222691629489bf9f20935247c76b11b31dcd6d7")
+	sshTagHash := mustHash(t, "e8d2ac104f5b2db1dad8764cf475b12881ced641")
+	pgpTagHash := mustHash(t, "2c0cfb47acea132db544424c6bc0dc64797dfd8d")
+	unsignedHash := mustHash(t, "08585692ce06452da6f82ae66b90d98b55536fca")
+
+	r := objects{
+		sshCommitHash: sshCommit,
+		pgpCommitHash: pgpCommit,
+		sshTagHash:    sshTag,
+		pgpTagHash:    pgpTag,
+		unsignedHash:  "tree 08585692ce06452da6f82ae66b90d98b55536fca\n\nchore: unsigned\n",
+	}
+	both := verify.Keyring{newOpenPGP(t, pgpPublicKey), newSSH(t, allowedSigners)}
+	commits := []git.Commit{{Hash: sshCommitHash}, {Hash: pgpCommitHash}}
+	tag := git.Tag{Name: "v1.0.1", Object: pgpTagHash, Commit: pgpCommitHash, Annotated: true}
+
+	tests := []struct {
+		name    string
+		policy  verify.Policy
+		tag     git.Tag
+		commits []git.Commit
+		want    []git.Hash
+	}{
+		{
+			name:    "all signed",
+			policy:  verify.Policy{Verifier: both, RequireSignedCommits: true, RequireSignedTag: true},
+			tag:     tag,
+			commits: commits,
+		},
+		{
+			name:    "nothing required",
+			policy:  verify.Policy{},
+			tag:     git.Tag{Name: "v1.0.1", Object: pgpCommitHash, Commit: pgpCommitHash},
+			commits: []git.Commit{{Hash: unsignedHash}},
+		},
+		{
+			name:    "untrusted format",
+			policy:  verify.Policy{Verifier: newSSH(t, allowedSigners), RequireSignedCommits: true, RequireSignedTag: true},
+			tag:     tag,
+			commits: commits,
+			want:    []git.Hash{pgpTagHash, pgpCommitHash},
+		},
+		{
+			name:    "unsigned and missing commits",
+			policy:  verify.Policy{Verifier: both, RequireSignedCommits: true},
+			tag:     git.Tag{Name: "v1.0.1", Object: pgpCommitHash, Commit: pgpCommitHash},
+			commits: []git.Commit{{Hash: sshCommitHash}, {Hash: unsignedHash}, {Hash: mustHash(t, "0000000000000000000000000000000000000001")}},
+			want:    []git.Hash{unsignedHash, mustHash(t, "0000000000000000000000000000000000000001")},
+		},
+		{
+			name:    "lightweight tag",
+			policy:  verify.Policy{Verifier: both, RequireSignedTag: true},
+			tag:     git.Tag{Name: "v1.0.1", Object: pgpCommitHash, Commit: pgpCommitHash},
+			commits: []git.Commit{{Hash: unsignedHash}},
+			want:    []git.Hash{pgpCommitHash},
+		},
+		{
+			name:   "no verifier",
+			policy: verify.Policy{RequireSignedTag: true},
+			tag:    git.Tag{Name: "v1.0.0", Object: sshTagHash, Commit: sshCommitHash, Annotated: true},
+			want:   []git.Hash{sshTagHash},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Check(r, tt.tag, tt.commits)
+			got := violations(t, err)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Check() violations = %v, want %v (error: %v)", got, tt.want, err)
+			}
+		})
+	}
+	err := verify.Policy{Verifier: both, RequireSignedTag: true}.CheckTag(r, git.Tag{Name: "v1.0.1", Object: pgpCommitHash, Commit: pgpCommitHash})
+	if !errors.Is(err, verify.ErrUnsigned) {
+		t.Errorf("CheckTag(lightweight) error = %v, want ErrUnsigned", err)
+	}
+	if want := "tag c222691: object is not signed: v1.0.1 is a lightweight tag"; err == nil || err.Error() != want {
+		t.Errorf("CheckTag(lightweight) error = %v, want %q", err, want)
+	}
+}
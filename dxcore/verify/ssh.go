@@ -0,0 +1,325 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package verify
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+// sshNamespace is the namespace git signs commits and tags in.
+const sshNamespace = "git"
+
+// AllowedSigner is one entry of an allowed_signers file, the trust store
+// configured with "gpg.ssh.allowedSignersFile". See the ALLOWED SIGNERS
+// section of ssh-keygen(1) for the format.
+type AllowedSigner struct {
+	// Principals are the patterns of the principals the key may sign as,
+	// typically email addresses. As in ssh-keygen, they are reported but
+	// not matched against the committer: a good signature by a listed key
+	// is trusted whatever identity the commit claims.
+	Principals []string
+
+	// Namespaces restricts the key to the signature namespaces matching
+	// one of these patterns. Empty means any namespace.
+	Namespaces []string
+
+	// ValidAfter and ValidBefore bound the period the key is trusted in.
+	// Signatures are dated with the time of the committer or tagger, as
+	// git does. Zero means unbounded.
+	ValidAfter  time.Time
+	ValidBefore time.Time
+
+	// CertAuthority marks a certificate authority key. Signatures made with
+	// SSH certificates are not supported, so such entries never match.
+	CertAuthority bool
+
+	// Key is the public key.
+	Key ssh.PublicKey
+}
+
+// ParseAllowedSigners parses the content of an allowed_signers file. Blank
+// lines and lines starting with "#" are ignored.
+func ParseAllowedSigners(data []byte) ([]AllowedSigner, error) {
+	var signers []AllowedSigner
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		s, err := parseAllowedSigner(line)
+		if err != nil {
+			return nil, fmt.Errorf("allowed signers line %d: %w", n, err)
+		}
+		signers = append(signers, s)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return signers, nil
+}
+
+func parseAllowedSigner(line string) (AllowedSigner, error) {
+	var s AllowedSigner
+	principals, rest := cutField(line)
+	if principals == "" || rest == "" {
+		return s, fmt.Errorf("missing public key")
+	}
+	s.Principals = strings.Split(strings.Trim(principals, `"`), ",")
+
+	key, _, options, _, err := ssh.ParseAuthorizedKey([]byte(rest))
+	if err != nil {
+		return s, err
+	}
+	s.Key = key
+
+	for _, opt := range options {
+		name, value, hasValue := strings.Cut(opt, "=")
+		value = strings.Trim(value, `"`)
+		switch {
+		case strings.EqualFold(name, "cert-authority") && !hasValue:
+			s.CertAuthority = true
+		case strings.EqualFold(name, "namespaces") && hasValue:
+			s.Namespaces = strings.Split(value, ",")
+		case strings.EqualFold(name, "valid-after") && hasValue:
+			if s.ValidAfter, err = parseSignerTime(value); err != nil {
+				return s, err
+			}
+		case strings.EqualFold(name, "valid-before") && hasValue:
+			if s.ValidBefore, err = parseSignerTime(value); err != nil {
+				return s, err
+			}
+		default:
+			return s, fmt.Errorf("unsupported option %q", opt)
+		}
+	}
+	return s, nil
+}
+
+// cutField splits the first whitespace-separated field, which may be
+// double-quoted, off line.
+func cutField(line string) (field, rest string) {
+	end := strings.IndexAny(line, " \t")
+	if strings.HasPrefix(line, `"`) {
+		if i := strings.Index(line[1:], `"`); i >= 0 {
+			end = i + 2
+		}
+	}
+	if end < 0 || end > len(line) {
+		return line, ""
+	}
+	return line[:end], strings.TrimSpace(line[end:])
+}
+
+// parseSignerTime parses a valid-after or valid-before time, in the form
+// YYYYMMDD[HHMM[SS]] with an optional "Z" suffix for UTC. Times without the
+// suffix are in the local time zone, as in ssh-keygen.
+func parseSignerTime(s string) (time.Time, error) {
+	loc := time.Local
+	if v, ok := strings.CutSuffix(s, "Z"); ok {
+		s, loc = v, time.UTC
+	}
+	layouts := map[int]string{8: "20060102", 12: "200601021504", 14: "20060102150405"}
+	layout, ok := layouts[len(s)]
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid time %q", s)
+	}
+	t, err := time.ParseInLocation(layout, s, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q", s)
+	}
+	return t, nil
+}
+
+// matchPattern reports whether s matches an OpenSSH pattern list entry,
+// where "*" matches any run of characters and "?" any one character.
+func matchPattern(pattern, s string) bool {
+	for pattern != "" {
+		switch pattern[0] {
+		case '*':
+			for i := len(s); i >= 0; i-- {
+				if matchPattern(pattern[1:], s[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if s == "" {
+				return false
+			}
+		default:
+			if s == "" || s[0] != pattern[0] {
+				return false
+			}
+		}
+		pattern, s = pattern[1:], s[1:]
+	}
+	return s == ""
+}
+
+// matchList reports whether s matches a comma-separated OpenSSH pattern
+// list, in which a pattern prefixed with "!" excludes what it matches.
+func matchList(patterns []string, s string) bool {
+	matched := false
+	for _, p := range patterns {
+		if neg, ok := strings.CutPrefix(p, "!"); ok {
+			if matchPattern(neg, s) {
+				return false
+			}
+		} else if matchPattern(p, s) {
+			matched = true
+		}
+	}
+	return matched
+}
+
+// SSH verifies SSH signatures against the keys of an allowed_signers file.
+type SSH struct {
+	signers []AllowedSigner
+}
+
+// NewSSH returns a Verifier that trusts the keys of signers, typically
+// parsed with ParseAllowedSigners.
+func NewSSH(signers []AllowedSigner) *SSH {
+	return &SSH{signers: signers}
+}
+
+// sshSignature is the SSHSIG blob of an armored SSH signature, after its
+// magic preamble. See PROTOCOL.sshsig in the OpenSSH sources.
+type sshSignature struct {
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// sshSignedData is the message an SSHSIG signature is computed over, after
+// the magic preamble.
+type sshSignedData struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+const sshSigMagic = "SSHSIG"
+
+// Verify implements Verifier.
+func (v *SSH) Verify(obj git.SignedObject) (Result, error) {
+	if !obj.IsSigned() {
+		return Result{}, ErrUnsigned
+	}
+	if obj.Format != git.SignatureSSH {
+		return Result{}, fmt.Errorf("%w: %s", ErrUnsupportedFormat, obj.Format)
+	}
+
+	block, _ := pem.Decode(obj.Signature)
+	if block == nil || block.Type != "SSH SIGNATURE" {
+		return Result{}, fmt.Errorf("cannot decode SSH signature armor")
+	}
+	blob, ok := bytes.CutPrefix(block.Bytes, []byte(sshSigMagic))
+	if !ok {
+		return Result{}, fmt.Errorf("cannot decode SSH signature: missing %s preamble", sshSigMagic)
+	}
+	var sig sshSignature
+	if err := ssh.Unmarshal(blob, &sig); err != nil {
+		return Result{}, fmt.Errorf("cannot decode SSH signature: %w", err)
+	}
+	if sig.Version != 1 {
+		return Result{}, fmt.Errorf("cannot decode SSH signature: unsupported version %d", sig.Version)
+	}
+	key, err := ssh.ParsePublicKey(sig.PublicKey)
+	if err != nil {
+		return Result{}, fmt.Errorf("cannot decode SSH signature key: %w", err)
+	}
+	var inner ssh.Signature
+	if err := ssh.Unmarshal(sig.Signature, &inner); err != nil {
+		return Result{}, fmt.Errorf("cannot decode SSH signature: %w", err)
+	}
+
+	if sig.Namespace != sshNamespace {
+		return Result{}, fmt.Errorf("%w: signature namespace is %q, want %q",
+			ErrBadSignature, sig.Namespace, sshNamespace)
+	}
+	var digest []byte
+	switch sig.HashAlgorithm {
+	case "sha256":
+		sum := sha256.Sum256(obj.Payload)
+		digest = sum[:]
+	case "sha512":
+		sum := sha512.Sum512(obj.Payload)
+		digest = sum[:]
+	default:
+		return Result{}, fmt.Errorf("cannot verify SSH signature: unsupported hash algorithm %q", sig.HashAlgorithm)
+	}
+	signed := append([]byte(sshSigMagic), ssh.Marshal(sshSignedData{
+		Namespace:     sig.Namespace,
+		Reserved:      sig.Reserved,
+		HashAlgorithm: sig.HashAlgorithm,
+		Hash:          digest,
+	})...)
+	if err := key.Verify(signed, &inner); err != nil {
+		return Result{}, fmt.Errorf("%w: %v", ErrBadSignature, err)
+	}
+
+	fingerprint := ssh.FingerprintSHA256(key)
+	signer, err := v.trusted(key, obj.Signer.When)
+	if err != nil {
+		return Result{}, fmt.Errorf("%w: %s: %v", ErrUntrustedKey, fingerprint, err)
+	}
+	return Result{
+		Format:      git.SignatureSSH,
+		Fingerprint: fingerprint,
+		Signer:      signer.Principals[0],
+	}, nil
+}
+
+// trusted returns the first entry that trusts key for git signatures made
+// at when.
+func (v *SSH) trusted(key ssh.PublicKey, when time.Time) (AllowedSigner, error) {
+	want := key.Marshal()
+	err := fmt.Errorf("key is not in allowed signers")
+	for _, s := range v.signers {
+		if s.CertAuthority || !bytes.Equal(s.Key.Marshal(), want) {
+			continue
+		}
+		switch {
+		case len(s.Namespaces) > 0 && !matchList(s.Namespaces, sshNamespace):
+			err = fmt.Errorf("key is not allowed in namespace %q", sshNamespace)
+		case !s.ValidAfter.IsZero() && when.Before(s.ValidAfter):
+			err = fmt.Errorf("key is not valid before %s", s.ValidAfter.Format(time.RFC3339))
+		case !s.ValidBefore.IsZero() && !when.Before(s.ValidBefore):
+			err = fmt.Errorf("key is not valid after %s", s.ValidBefore.Format(time.RFC3339))
+		default:
+			return s, nil
+		}
+	}
+	return AllowedSigner{}, err
+}
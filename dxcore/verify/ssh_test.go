@@ -0,0 +1,175 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package verify_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/verify"
+)
+
+// sshKey is the public key of the SSH fixtures, in authorized_keys format.
+var sshKey = strings.SplitN(strings.TrimSpace(allowedSigners), " ", 3)[2]
+
+func TestParseAllowedSigners(t *testing.T) {
+	data := "# maintainers\n" +
+		"\n" +
+		"jane@example.com,*@dev.example.com " + sshKey + " laptop\n" +
+		"\"john@example.com,ops@example.com\" namespaces=\"git,file\",valid-after=\"20250101\",valid-before=\"202601021504Z\" " + sshKey + "\n" +
+		"*@example.com cert-authority " + sshKey + "\n"
+
+	signers, err := verify.ParseAllowedSigners([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseAllowedSigners() error = %v", err)
+	}
+	if len(signers) != 3 {
+		t.Fatalf("ParseAllowedSigners() returned %d signers, want 3", len(signers))
+	}
+
+	if got := strings.Join(signers[0].Principals, " "); got != "jane@example.com *@dev.example.com" {
+		t.Errorf("signers[0].Principals = %q", got)
+	}
+	if signers[0].Key == nil || signers[0].Namespaces != nil || !signers[0].ValidAfter.IsZero() {
+		t.Errorf("signers[0] = %+v", signers[0])
+	}
+
+	s := signers[1]
+	if got := strings.Join(s.Principals, " "); got != "john@example.com ops@example.com" {
+		t.Errorf("signers[1].Principals = %q", got)
+	}
+	if got := strings.Join(s.Namespaces, " "); got != "git file" {
+		t.Errorf("signers[1].Namespaces = %q", got)
+	}
+	if want := time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local); !s.ValidAfter.Equal(want) {
+		t.Errorf("signers[1].ValidAfter = %v, want %v", s.ValidAfter, want)
+	}
+	if want := time.Date(2026, 1, 2, 15, 4, 0, 0, time.UTC); !s.ValidBefore.Equal(want) {
+		t.Errorf("signers[1].ValidBefore = %v, want %v", s.ValidBefore, want)
+	}
+
+	if !signers[2].CertAuthority {
+		t.Error("signers[2].CertAuthority = false, want true")
+	}
+}
+
+func TestParseAllowedSigners_Errors(t *testing.T) {
+	for name, data := range map[string]string{
+		"no key":         "jane@example.com\n",
+		"bad key":        "jane@example.com ssh-ed25519 AAAA\n",
+		"unknown option": "jane@example.com no-touch-required " + sshKey + "\n",
+		"bad time":       "jane@example.com valid-after=\"2025\" " + sshKey + "\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := verify.ParseAllowedSigners([]byte("# header\n" + data))
+			if err == nil || !strings.Contains(err.Error(), "line 2") {
+				t.Errorf("ParseAllowedSigners() error = %v, want an error on line 2", err)
+			}
+		})
+	}
+}
+
+func TestSSH_Verify(t *testing.T) {
+	v := newSSH(t, allowedSigners)
+
+	for name, obj := range map[string]git.SignedObject{
+		"commit": mustParse(t, git.ParseSignedCommit, sshCommit),
+		"tag":    mustParse(t, git.ParseSignedTag, sshTag),
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := v.Verify(obj)
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			want := verify.Result{
+				Format:      git.SignatureSSH,
+				Fingerprint: sshFingerprint,
+				Signer:      "jane@example.com",
+			}
+			if res != want {
+				t.Errorf("Verify() = %+v, want %+v", res, want)
+			}
+		})
+	}
+}
+
+func TestSSH_Verify_Errors(t *testing.T) {
+	good := mustParse(t, git.ParseSignedCommit, sshCommit)
+
+	tampered := good
+	tampered.Payload = bytes.Replace(good.Payload, []byte("feat: add a"), []byte("feat: add b"), 1)
+
+	corrupt := good
+	corrupt.Signature = []byte("-----BEGIN SSH SIGNATURE-----\nU1NIU0lH\n-----END SSH SIGNATURE-----\n")
+
+	pub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(other)))
+
+	tests := []struct {
+		name    string
+		signers string
+		obj     git.SignedObject
+		wantErr error
+	}{
+		{name: "tampered", signers: allowedSigners, obj: tampered, wantErr: verify.ErrBadSignature},
+		{name: "untrusted", signers: "jane@example.com " + otherKey, obj: good, wantErr: verify.ErrUntrustedKey},
+		{name: "namespace", signers: `jane@example.com namespaces="file" ` + sshKey, obj: good, wantErr: verify.ErrUntrustedKey},
+		{name: "negated namespace", signers: `jane@example.com namespaces="*,!git" ` + sshKey, obj: good, wantErr: verify.ErrUntrustedKey},
+		{name: "not yet valid", signers: `jane@example.com valid-after="20250201Z" ` + sshKey, obj: good, wantErr: verify.ErrUntrustedKey},
+		{name: "no longer valid", signers: `jane@example.com valid-before="20250115Z" ` + sshKey, obj: good, wantErr: verify.ErrUntrustedKey},
+		{name: "cert authority", signers: "jane@example.com cert-authority " + sshKey, obj: good, wantErr: verify.ErrUntrustedKey},
+		{name: "openpgp", signers: allowedSigners, obj: mustParse(t, git.ParseSignedCommit, pgpCommit), wantErr: verify.ErrUnsupportedFormat},
+		{name: "unsigned", signers: allowedSigners, obj: git.SignedObject{Payload: good.Payload}, wantErr: verify.ErrUnsigned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newSSH(t, tt.signers).Verify(tt.obj)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := newSSH(t, allowedSigners).Verify(corrupt); err == nil {
+		t.Error("Verify() of a corrupt signature succeeded, want error")
+	}
+	valid := `jane@example.com namespaces="g*",valid-after="20250115Z",valid-before="20250116Z" ` + sshKey
+	if _, err := newSSH(t, valid).Verify(good); err != nil {
+		t.Errorf("Verify() within the validity period error = %v", err)
+	}
+
+	headers, message, _ := strings.Cut(sshCommit, "\n\n")
+	dual := headers + "\ngpgsig-sha256 -----BEGIN SSH SIGNATURE-----\n AAAA\n -----END SSH SIGNATURE-----\n\n" + message
+	if _, err := newSSH(t, allowedSigners).Verify(mustParse(t, git.ParseSignedCommit, dual)); err != nil {
+		t.Errorf("Verify() of a commit with two signature headers error = %v", err)
+	}
+}
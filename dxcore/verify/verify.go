@@ -0,0 +1,97 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package verify checks the signatures of git commits and tags. A Verifier
+// checks one git.SignedObject against the keys it trusts; OpenPGP verifies
+// signatures made with "gpg.format = openpgp" against an armored keyring and
+// SSH verifies signatures made with "gpg.format = ssh" against an
+// allowed_signers file. Both work offline: no keyserver, agent or external
+// gpg or ssh-keygen binary is consulted. Policy applies a Verifier to the
+// commits and tag of a release.
+package verify
+
+import (
+	"errors"
+	"fmt"
+
+	"dirpx.dev/dxrel/dxcore/model/git"
+)
+
+var (
+	// ErrUnsigned is returned when the object carries no signature.
+	ErrUnsigned = errors.New("object is not signed")
+
+	// ErrUnsupportedFormat is returned by a Verifier given a signature
+	// format it does not handle, for example an SSH signature passed to
+	// an OpenPGP verifier.
+	ErrUnsupportedFormat = errors.New("unsupported signature format")
+
+	// ErrUntrustedKey is returned when the signature was made by a key the
+	// Verifier does not trust, or by a trusted key outside the principals,
+	// namespaces or validity period it is trusted for.
+	ErrUntrustedKey = errors.New("signed by an untrusted key")
+
+	// ErrBadSignature is returned when the signature does not match the
+	// signed payload, typically because the object was altered after it
+	// was signed.
+	ErrBadSignature = errors.New("bad signature")
+)
+
+// Result describes a good signature by a trusted key.
+type Result struct {
+	// Format is the signature format.
+	Format git.SignatureFormat
+
+	// Fingerprint identifies the signing key: the uppercase hex fingerprint
+	// of an OpenPGP key, or the "SHA256:" fingerprint of an SSH key, as
+	// printed by gpg and ssh-keygen respectively.
+	Fingerprint string
+
+	// Signer names the owner of the key: the primary user id of an OpenPGP
+	// key, or the principal of the allowed_signers entry of an SSH key.
+	Signer string
+}
+
+// Verifier checks the signature of a commit or tag object.
+//
+// Verify returns the Result of a good signature by a trusted key. Otherwise
+// it returns an error that wraps ErrUnsigned, ErrUnsupportedFormat,
+// ErrUntrustedKey or ErrBadSignature, or another error when the signature
+// itself cannot be decoded.
+type Verifier interface {
+	Verify(obj git.SignedObject) (Result, error)
+}
+
+// Keyring is a Verifier that combines verifiers for several formats, so that
+// a repository whose maintainers sign with both OpenPGP and SSH keys can be
+// checked with a single Verifier. Verify passes the object to each verifier
+// in order and returns the answer of the first that supports its format.
+type Keyring []Verifier
+
+// Verify implements Verifier.
+func (k Keyring) Verify(obj git.SignedObject) (Result, error) {
+	if !obj.IsSigned() {
+		return Result{}, ErrUnsigned
+	}
+	for _, v := range k {
+		res, err := v.Verify(obj)
+		if errors.Is(err, ErrUnsupportedFormat) {
+			continue
+		}
+		return res, err
+	}
+	return Result{}, fmt.Errorf("%w: %s", ErrUnsupportedFormat, obj.Format)
+}
@@ -0,0 +1,186 @@
+/*
+   Copyright 2025 The DIRPX Authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package verify_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"dirpx.dev/dxrel/dxcore/model/git"
+	"dirpx.dev/dxrel/dxcore/verify"
+)
+
+// The fixtures below were made with git 2.39, ssh-keygen and gpg: a commit
+// and an annotated tag signed with an SSH key, and a commit and an annotated
+// tag signed with an OpenPGP key, in one repository.
+
+const sshCommit = `tree 08585692ce06452da6f82ae66b90d98b55536fca
+author Jane Doe <jane@example.com> 1736933400 +0100
+committer Jane Doe <jane@example.com> 1736933400 +0100
+gpgsig -----BEGIN SSH SIGNATURE-----
+ U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAg7QZnEHbnwZgtw2M3XocRaYDzmR
+ JW8PUblZ1klmPwBRUAAAADZ2l0AAAAAAAAAAZzaGE1MTIAAABTAAAAC3NzaC1lZDI1NTE5
+ AAAAQO9L/MKM7JX+zajKDtFKgE5eU0rVsQbbMvIdmqQbeM8WaSUZTzF5gBFUHm2/MjHeIS
+ B2U0o3Ci0op4Hd01DkBgA=
+ -----END SSH SIGNATURE-----
+
+feat: add a
+
+Body text.
+`
+
+const pgpCommit = `tree f4b354863caa9cea99b95422c9dab70465757d87
+parent 9ff2ebb8e719cb7019ee5a046fca22d05acf8de0
+author Jane Doe <jane@example.com> 1737018000 +0000
+committer Jane Doe <jane@example.com> 1737018000 +0000
+gpgsig -----BEGIN PGP SIGNATURE-----
+ 
+ iHUEABYIAB0WIQSMUuV8i7lTnYnZjqE0EzmMt6vFaAUCatS+TAAKCRA0EzmMt6vF
+ aLWBAQDLTEniLZSz7ru/s0XbI9Yxi64XVcd0di/DkCLEzUDodwD9FvC9IfF9hPMI
+ j9z1AtGT9sRjmdYQL0pDS1OlRX3R5gQ=
+ =51TW
+ -----END PGP SIGNATURE-----
+
+fix: add b
+`
+
+const sshTag = `object 9ff2ebb8e719cb7019ee5a046fca22d05acf8de0
+type commit
+tag v1.0.0
+tagger Jane Doe <jane@example.com> 1736933460 +0100
+
+Release 1.0.0
+-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAg7QZnEHbnwZgtw2M3XocRaYDzmR
+JW8PUblZ1klmPwBRUAAAADZ2l0AAAAAAAAAAZzaGE1MTIAAABTAAAAC3NzaC1lZDI1NTE5
+AAAAQFt6IRaFBuTi3cZMZNxRVMjYfQuvZYaJiZ4JyMvT70VrWlNzVJNjUl58U7RMbEnSQC
+DAqvti9+DlK8yawtFwYwI=
+-----END SSH SIGNATURE-----
+`
+
+const pgpTag = `object c222691629489bf9f20935247c76b11b31dcd6d7
+type commit
+tag v1.0.1
+tagger Jane Doe <jane@example.com> 1737018060 +0000
+
+Release 1.0.1
+-----BEGIN PGP SIGNATURE-----
+
+iHUEABYIAB0WIQSMUuV8i7lTnYnZjqE0EzmMt6vFaAUCatS+TAAKCRA0EzmMt6vF
+aFl6AP0TCesPgIjwlIBmMm00owgXvMYUdG0CPc0diadkD7uDugEAtiw2lPgosXP9
+XvaSoZOESn8V2f+qbMmhpdwKKfx78Q4=
+=UFQM
+-----END PGP SIGNATURE-----
+`
+
+const allowedSigners = `jane@example.com namespaces="git" ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIO0GZxB258GYLcNjN16HEWmA85kSVvD1G5WdZJZj8AUV
+`
+
+const pgpPublicKey = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mDMEatS+TBYJKwYBBAHaRw8BAQdA5IlGmy70CDqehJkhSEnRtsHAfJikGEqAzyqX
+xfrtv/S0G0phbmUgRG9lIDxqYW5lQGV4YW1wbGUuY29tPoiQBBMWCAA4FiEEjFLl
+fIu5U52J2Y6hNBM5jLerxWgFAmrUvkwCGwMFCwkIBwIGFQoJCAsCBBYCAwECHgEC
+F4AACgkQNBM5jLerxWi5rwEA3luwYW4MdMEBEbJ2s5gYG2TZzwrwY1mip/5s5o57
+KJ4BAI9f7uUIQSYO4znzG9W4NAB/CqdH2j/NEaMxRm4n5kkL
+=6Zhx
+-----END PGP PUBLIC KEY BLOCK-----
+`
+
+const (
+	sshFingerprint = "SHA256:EhionXm4TEftmzzBXvPDb2535TrFBVOizENX/E8Yuto"
+	pgpFingerprint = "8C52E57C8BB9539D89D98EA13413398CB7ABC568"
+)
+
+func mustParse(t *testing.T, parse func([]byte) (git.SignedObject, error), raw string) git.SignedObject {
+	t.Helper()
+	obj, err := parse([]byte(raw))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	return obj
+}
+
+func newSSH(t *testing.T, signers string) *verify.SSH {
+	t.Helper()
+	s, err := verify.ParseAllowedSigners([]byte(signers))
+	if err != nil {
+		t.Fatalf("ParseAllowedSigners() error = %v", err)
+	}
+	return verify.NewSSH(s)
+}
+
+func newOpenPGP(t *testing.T, armored string) *verify.OpenPGP {
+	t.Helper()
+	v, err := verify.NewOpenPGP(strings.NewReader(armored))
+	if err != nil {
+		t.Fatalf("NewOpenPGP() error = %v", err)
+	}
+	return v
+}
+
+func TestKeyring_Verify(t *testing.T) {
+	k := verify.Keyring{newOpenPGP(t, pgpPublicKey), newSSH(t, allowedSigners)}
+
+	tests := []struct {
+		name        string
+		obj         git.SignedObject
+		fingerprint string
+		wantErr     error
+	}{
+		{name: "ssh", obj: mustParse(t, git.ParseSignedCommit, sshCommit), fingerprint: sshFingerprint},
+		{name: "openpgp", obj: mustParse(t, git.ParseSignedTag, pgpTag), fingerprint: pgpFingerprint},
+		{
+			name:    "unsigned",
+			obj:     git.SignedObject{Type: "commit", Payload: []byte("tree x\n")},
+			wantErr: verify.ErrUnsigned,
+		},
+		{
+			name: "x509",
+			obj: git.SignedObject{
+				Type:      "commit",
+				Payload:   []byte("tree x\n"),
+				Signature: []byte("-----BEGIN SIGNED MESSAGE-----\n-----END SIGNED MESSAGE-----\n"),
+				Format:    git.SignatureX509,
+			},
+			wantErr: verify.ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := k.Verify(tt.obj)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if res.Fingerprint != tt.fingerprint || res.Format != tt.obj.Format {
+				t.Errorf("Verify() = %+v, want %s key %s", res, tt.obj.Format, tt.fingerprint)
+			}
+		})
+	}
+
+	if _, err := (verify.Keyring{}).Verify(mustParse(t, git.ParseSignedCommit, sshCommit)); !errors.Is(err, verify.ErrUnsupportedFormat) {
+		t.Errorf("empty Keyring Verify() error = %v, want ErrUnsupportedFormat", err)
+	}
+}
@@ -2,11 +2,22 @@ module dirpx.dev/dxrel
 
 go 1.25.4
 
-require gopkg.in/yaml.v3 v3.0.1
+require (
+	dirpx.dev/rxmerr v0.1.1
+	github.com/BurntSushi/toml v1.6.0
+	github.com/ProtonMail/go-crypto v1.3.0
+	github.com/blang/semver/v4 v4.0.0
+	github.com/fxamacker/cbor/v2 v2.9.1
+	github.com/rivo/uniseg v0.4.7
+	golang.org/x/crypto v0.54.0
+	golang.org/x/term v0.45.0
+	golang.org/x/text v0.40.0
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
-	dirpx.dev/rxmerr v0.1.1 // indirect
-	github.com/blang/semver/v4 v4.0.0 // indirect
+	github.com/cloudflare/circl v1.6.1 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
 	go.uber.org/multierr v1.11.0 // indirect
-	golang.org/x/mod v0.30.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
 )